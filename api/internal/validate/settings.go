@@ -0,0 +1,95 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validate
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/apimachinery/pkg/util/version"
+)
+
+// settings lists the typed docling-serve settings, the environment variable each one is passed as and the first
+// docling-serve release reading it. Settings read by every supported release have no minimum version.
+var settings = []struct {
+	field      string
+	env        string
+	minVersion string
+}{
+	{"maxSyncWait", "DOCLING_SERVE_MAX_SYNC_WAIT", ""},
+	{"maxNumPages", "DOCLING_SERVE_MAX_NUM_PAGES", ""},
+	{"maxFileSize", "DOCLING_SERVE_MAX_FILE_SIZE", ""},
+	{"corsOrigins", "DOCLING_SERVE_CORS_ORIGINS", ""},
+	{"logLevel", "UVICORN_LOG_LEVEL", ""},
+	{"artifactsPath", "DOCLING_SERVE_ARTIFACTS_PATH", ""},
+	{"resultRemovalDelay", "DOCLING_SERVE_RESULT_REMOVAL_DELAY", "0.7.0"},
+	{"enableRemoteServices", "DOCLING_SERVE_ENABLE_REMOTE_SERVICES", "0.8.0"},
+	{"allowedOCREngines", "DOCLING_SERVE_ALLOWED_OCR_ENGINES", "1.0.0"},
+}
+
+// Settings checks that the docling-serve image reads the settings that are set, and that no environment variable
+// overrides them. The settings are the Settings struct of either API version.
+func Settings(fldPath *field.Path, image string, values any, envPath *field.Path, env []corev1.EnvVar) field.ErrorList {
+	var allErrs field.ErrorList
+	data, err := json.Marshal(values)
+	if err != nil {
+		return append(allErrs, field.InternalError(fldPath, err))
+	}
+	set := map[string]any{}
+	if err := json.Unmarshal(data, &set); err != nil {
+		return append(allErrs, field.InternalError(fldPath, err))
+	}
+
+	imageVersion := ImageVersion(image)
+	for _, setting := range settings {
+		if _, ok := set[setting.field]; !ok {
+			continue
+		}
+		if setting.minVersion != "" && imageVersion != nil && !imageVersion.AtLeast(version.MustParseGeneric(setting.minVersion)) {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child(setting.field),
+				fmt.Sprintf("requires docling-serve %s or later, the image runs %s", setting.minVersion, imageVersion)))
+		}
+		for i, envVar := range env {
+			if envVar.Name == setting.env {
+				allErrs = append(allErrs, field.Forbidden(envPath.Index(i).Child("name"),
+					fmt.Sprintf("%s is set from %s", envVar.Name, fldPath.Child(setting.field))))
+			}
+		}
+	}
+	return allErrs
+}
+
+// ImageVersion returns the docling-serve version of the image tag, or nil when the image is referenced by digest or
+// by a tag that is not a version, such as latest.
+func ImageVersion(image string) *version.Version {
+	if strings.Contains(image, "@") {
+		return nil
+	}
+	name := image[strings.LastIndex(image, "/")+1:]
+	tagIndex := strings.LastIndex(name, ":")
+	if tagIndex < 0 {
+		return nil
+	}
+	imageVersion, err := version.ParseGeneric(name[tagIndex+1:])
+	if err != nil {
+		return nil
+	}
+	return imageVersion
+}
@@ -0,0 +1,132 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package validate holds the admission checks shared by the DoclingServe API versions.
+package validate
+
+import (
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// DerivedNames checks that the names of the managed resources, built by appending the suffixes to the name of the
+// resource, are valid DNS-1035 labels.
+func DerivedNames(name string, suffixes []string) field.ErrorList {
+	var allErrs field.ErrorList
+	for _, suffix := range suffixes {
+		for _, msg := range validation.IsDNS1035Label(name + suffix) {
+			allErrs = append(allErrs, field.Invalid(field.NewPath("metadata", "name"), name,
+				fmt.Sprintf("the derived resource name %q is invalid: %s", name+suffix, msg)))
+		}
+	}
+	return allErrs
+}
+
+// AutoscalingRequests warns about utilization targets that cannot be computed, because the matching resource is not requested.
+// The CPU target is used when no target is configured.
+func AutoscalingRequests(workloadPath *field.Path, resources *corev1.ResourceRequirements, targetCPU, targetMemory *int32) []string {
+	var warnings []string
+	requested := func(name corev1.ResourceName) bool {
+		if resources == nil {
+			return false
+		}
+		_, ok := resources.Requests[name]
+		return ok
+	}
+	if (targetCPU != nil || targetMemory == nil) && !requested(corev1.ResourceCPU) {
+		warnings = append(warnings, fmt.Sprintf("%s: the autoscaler targets the CPU utilization but no CPU request is set", workloadPath.Child("resources")))
+	}
+	if targetMemory != nil && !requested(corev1.ResourceMemory) {
+		warnings = append(warnings, fmt.Sprintf("%s: the autoscaler targets the memory utilization but no memory request is set", workloadPath.Child("resources")))
+	}
+	return warnings
+}
+
+// UsesLatestTag reports whether the image reference resolves to the mutable latest tag.
+func UsesLatestTag(image string) bool {
+	if strings.Contains(image, "@") {
+		return false
+	}
+	name := image[strings.LastIndex(image, "/")+1:]
+	tagIndex := strings.LastIndex(name, ":")
+	return tagIndex < 0 || name[tagIndex+1:] == "latest"
+}
+
+// Endpoint checks that the endpoint is an absolute http(s) URL.
+func Endpoint(endpoint string) error {
+	parsed, err := url.Parse(endpoint)
+	if err != nil {
+		return fmt.Errorf("must be a valid URL: %w", err)
+	}
+	if parsed.Scheme != "http" && parsed.Scheme != "https" {
+		return fmt.Errorf("must use the http or https scheme")
+	}
+	if parsed.Host == "" {
+		return fmt.Errorf("must include a host")
+	}
+	return nil
+}
+
+// RedisURL checks that the URL of an external Redis server can be used by the RQ engine.
+func RedisURL(redisURL string) error {
+	parsed, err := url.Parse(redisURL)
+	if err != nil {
+		return fmt.Errorf("must be a valid URL: %w", err)
+	}
+	if parsed.Scheme != "redis" && parsed.Scheme != "rediss" {
+		return fmt.Errorf("must use the redis or rediss scheme")
+	}
+	if parsed.Host == "" {
+		return fmt.Errorf("must include a host")
+	}
+	return nil
+}
+
+// ObjectName checks that the name of a referenced object is a valid DNS subdomain.
+func ObjectName(fldPath *field.Path, name string) field.ErrorList {
+	var allErrs field.ErrorList
+	for _, msg := range validation.IsDNS1123Subdomain(name) {
+		allErrs = append(allErrs, field.Invalid(fldPath, name, msg))
+	}
+	return allErrs
+}
+
+// operatorEnvVars lists the docling-serve environment variables set by the operator from the DoclingServe spec.
+var operatorEnvVars = []string{"DOCLING_SERVE_API_KEY", "DOCLING_SERVE_ENABLE_UI"}
+
+// operatorEnvVarPrefixes lists the prefixes of the environment variable families set by the operator: the engine
+// configuration and the TLS settings of Uvicorn.
+var operatorEnvVarPrefixes = []string{"DOCLING_SERVE_ENG_", "UVICORN_SSL_"}
+
+// EnvVars checks that the environment variables do not override the variables managed by the operator.
+func EnvVars(fldPath *field.Path, env []corev1.EnvVar) field.ErrorList {
+	var allErrs field.ErrorList
+	for i, envVar := range env {
+		if slices.Contains(operatorEnvVars, envVar.Name) || slices.ContainsFunc(operatorEnvVarPrefixes, func(prefix string) bool {
+			return strings.HasPrefix(envVar.Name, prefix)
+		}) {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Index(i).Child("name"),
+				fmt.Sprintf("%s is managed by the operator from the DoclingServe spec", envVar.Name)))
+		}
+	}
+	return allErrs
+}
@@ -0,0 +1,127 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// DoclingModelCacheSpec defines the desired state of DoclingModelCache
+type DoclingModelCacheSpec struct {
+	// Image is the docling-serve container image providing docling-tools, used to download the models.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Image",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="quay.io/docling-project/docling-serve:v1.0.0"
+	Image string `json:"image,omitempty"`
+
+	// StorageClassName is the storage class of the cache volume. It must support the ReadWriteMany access mode. The
+	// default storage class is used when it is empty.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Storage Class",xDescriptors={"urn:alm:descriptor:io.kubernetes:StorageClass"}
+	// +kubebuilder:validation:Optional
+	StorageClassName *string `json:"storageClassName,omitempty"`
+
+	// Size is the requested size of the cache volume. It can be increased when the storage class allows expansion.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="20Gi"
+	Size *resource.Quantity `json:"size,omitempty"`
+
+	// Bundles lists the model bundles to download, as named by docling-tools models download, e.g. layout,
+	// tableformer or easyocr. The default bundles of docling-tools are downloaded when it is empty.
+	// +kubebuilder:validation:Optional
+	// +listType=set
+	Bundles []string `json:"bundles,omitempty"`
+
+	// HFTokenSecretRef selects the key of a secret holding the Hugging Face token used by the download.
+	// +kubebuilder:validation:Optional
+	HFTokenSecretRef *v1.SecretKeySelector `json:"hfTokenSecretRef,omitempty"`
+
+	// PodSecurityContext overrides fields of the restricted security context the download pod runs with.
+	// +kubebuilder:validation:Optional
+	PodSecurityContext *v1.PodSecurityContext `json:"podSecurityContext,omitempty"`
+
+	// SecurityContext overrides fields of the restricted security context of the download container.
+	// +kubebuilder:validation:Optional
+	SecurityContext *v1.SecurityContext `json:"securityContext,omitempty"`
+
+	// NodeSelector restricts the download pod to the nodes with these labels.
+	// +kubebuilder:validation:Optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+
+	// Tolerations let the download pod run on tainted nodes, such as a dedicated node pool.
+	// +kubebuilder:validation:Optional
+	Tolerations []v1.Toleration `json:"tolerations,omitempty"`
+}
+
+// DoclingModelCacheStatus defines the observed state of DoclingModelCache
+type DoclingModelCacheStatus struct {
+	// Conditions describe the state of the cache. Ready is True once the models are downloaded.
+	// +patchMergeKey=type
+	// +patchStrategy=merge
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"  patchStrategy:"merge" patchMergeKey:"type"`
+
+	// ObservedGeneration is the generation last observed by the controller
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// ClaimName is the name of the persistent volume claim holding the models.
+	// +operator-sdk:csv:customresourcedefinitions:type=status,displayName="Claim",xDescriptors={"urn:alm:descriptor:io.kubernetes:PersistentVolumeClaim"}
+	// +optional
+	ClaimName string `json:"claimName,omitempty"`
+
+	// Models lists the model directories found in the cache after the last download.
+	// +optional
+	Models []string `json:"models,omitempty"`
+
+	// Size is the disk space used by the models after the last download.
+	// +operator-sdk:csv:customresourcedefinitions:type=status,displayName="Size",xDescriptors={"urn:alm:descriptor:text"}
+	// +optional
+	Size *resource.Quantity `json:"size,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].status"
+// +kubebuilder:printcolumn:name="Size",type="string",JSONPath=".status.size"
+// +kubebuilder:printcolumn:name="Claim",type="string",JSONPath=".status.claimName",priority=1
+// +kubebuilder:printcolumn:name="Reason",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].reason",priority=1
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// DoclingModelCache is the Schema for the doclingmodelcaches API. It downloads docling models once in a shared
+// volume, which DoclingServes in the same namespace mount by referencing it from spec.models.cacheName.
+type DoclingModelCache struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DoclingModelCacheSpec   `json:"spec,omitempty"`
+	Status DoclingModelCacheStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DoclingModelCacheList contains a list of DoclingModelCache
+type DoclingModelCacheList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DoclingModelCache `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&DoclingModelCache{}, &DoclingModelCacheList{})
+}
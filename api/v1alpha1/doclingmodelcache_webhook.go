@@ -0,0 +1,105 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.io/docling-project/docling-operator/api/internal/validate"
+)
+
+// log is for logging in this package.
+var doclingmodelcachelog = logf.Log.WithName("doclingmodelcache-resource")
+
+// SetupWebhookWithManager will setup the manager to manage the webhooks
+func (r *DoclingModelCache) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).For(r).
+		WithValidator(&DoclingModelCacheCustomValidator{}).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/validate-docling-github-io-v1alpha1-doclingmodelcache,mutating=false,failurePolicy=fail,sideEffects=None,groups=docling.github.io,resources=doclingmodelcaches,verbs=create;update,versions=v1alpha1,name=vdoclingmodelcache-v1alpha1.kb.io,admissionReviewVersions=v1
+
+// DoclingModelCacheCustomValidator validates the DoclingModelCache resource when it is created or updated.
+// +kubebuilder:object:generate=false
+type DoclingModelCacheCustomValidator struct{}
+
+var _ webhook.CustomValidator = &DoclingModelCacheCustomValidator{}
+
+// ValidateCreate implements webhook.CustomValidator so a webhook will be registered for the type DoclingModelCache.
+func (v *DoclingModelCacheCustomValidator) ValidateCreate(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	cache, ok := obj.(*DoclingModelCache)
+	if !ok {
+		return nil, fmt.Errorf("expected a DoclingModelCache object but got %T", obj)
+	}
+	doclingmodelcachelog.Info("Validation for DoclingModelCache upon creation", "name", cache.GetName())
+
+	return cache.validate(nil)
+}
+
+// ValidateUpdate implements webhook.CustomValidator so a webhook will be registered for the type DoclingModelCache.
+func (v *DoclingModelCacheCustomValidator) ValidateUpdate(_ context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	cache, ok := newObj.(*DoclingModelCache)
+	if !ok {
+		return nil, fmt.Errorf("expected a DoclingModelCache object for the newObj but got %T", newObj)
+	}
+	old, ok := oldObj.(*DoclingModelCache)
+	if !ok {
+		return nil, fmt.Errorf("expected a DoclingModelCache object for the oldObj but got %T", oldObj)
+	}
+	doclingmodelcachelog.Info("Validation for DoclingModelCache upon update", "name", cache.GetName())
+
+	return cache.validate(old)
+}
+
+// ValidateDelete implements webhook.CustomValidator so a webhook will be registered for the type DoclingModelCache.
+func (v *DoclingModelCacheCustomValidator) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// validate returns the warnings and the aggregated validation errors of the DoclingModelCache, updated from old unless
+// it is created.
+func (r *DoclingModelCache) validate(old *DoclingModelCache) (admission.Warnings, error) {
+	var warnings admission.Warnings
+	var allErrs field.ErrorList
+	if old == nil || old.Name != r.Name {
+		allErrs = append(allErrs, validate.DerivedNames(r.Name, []string{"-model-cache", "-model-cache-download"})...)
+	}
+
+	imagePath := field.NewPath("spec", "image")
+	if strings.TrimSpace(r.Spec.Image) == "" {
+		allErrs = append(allErrs, field.Required(imagePath, "a docling-serve image must be specified"))
+	} else if validate.UsesLatestTag(r.Spec.Image) {
+		warnings = append(warnings, fmt.Sprintf("%s: image %q uses the latest tag, a new download may fetch different models; pin a version or digest instead",
+			imagePath, r.Spec.Image))
+	}
+
+	if len(allErrs) == 0 {
+		return warnings, nil
+	}
+	return warnings, apierrors.NewInvalid(GroupVersion.WithKind("DoclingModelCache").GroupKind(), r.Name, allErrs)
+}
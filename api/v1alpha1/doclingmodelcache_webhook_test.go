@@ -0,0 +1,80 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("DoclingModelCache Webhook", func() {
+	var (
+		obj       *DoclingModelCache
+		validator DoclingModelCacheCustomValidator
+	)
+
+	BeforeEach(func() {
+		obj = &DoclingModelCache{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-cache", Namespace: "default"},
+			Spec:       DoclingModelCacheSpec{Image: "quay.io/docling-project/docling-serve:v1.0.0"},
+		}
+	})
+
+	Context("When creating or updating DoclingModelCache under Validating Webhook", func() {
+		It("Should admit a pinned image without warnings", func() {
+			warnings, err := validator.ValidateCreate(context.Background(), obj)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(BeEmpty())
+		})
+
+		It("Should deny a missing image", func() {
+			obj.Spec.Image = ""
+
+			_, err := validator.ValidateCreate(context.Background(), obj)
+			Expect(apierrors.IsInvalid(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("spec.image"))
+		})
+
+		It("Should warn about the latest tag", func() {
+			obj.Spec.Image = "quay.io/docling-project/docling-serve:latest"
+
+			warnings, err := validator.ValidateUpdate(context.Background(), obj, obj)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf(HavePrefix("spec.image")))
+		})
+
+		It("Should deny a name too long for the download job", func() {
+			obj.Name = strings.Repeat("a", 43)
+
+			_, err := validator.ValidateCreate(context.Background(), obj)
+			Expect(apierrors.IsInvalid(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("-model-cache-download"))
+		})
+
+		It("Should not check the derived names of an existing name", func() {
+			obj.Name = strings.Repeat("a", 43)
+
+			_, err := validator.ValidateUpdate(context.Background(), obj.DeepCopy(), obj)
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+})
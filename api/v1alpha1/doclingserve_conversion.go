@@ -0,0 +1,251 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"fmt"
+
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+
+	"github.io/docling-project/docling-operator/api/v1beta1"
+)
+
+// ConvertTo converts this DoclingServe to the Hub version (v1beta1).
+func (src *DoclingServe) ConvertTo(dstRaw conversion.Hub) error {
+	dst, ok := dstRaw.(*v1beta1.DoclingServe)
+	if !ok {
+		return fmt.Errorf("expected a v1beta1 DoclingServe but got %T", dstRaw)
+	}
+
+	dst.ObjectMeta = src.ObjectMeta
+
+	if src.Spec.APIServer != nil {
+		dst.Spec.Workload = &v1beta1.Workload{
+			Image:                     src.Spec.APIServer.Image,
+			EnableUI:                  src.Spec.APIServer.EnableUI,
+			Settings:                  (*v1beta1.Settings)(src.Spec.APIServer.Settings),
+			Replicas:                  src.Spec.APIServer.Instances,
+			ConfigMapName:             src.Spec.APIServer.ConfigMapName,
+			Env:                       src.Spec.APIServer.Env,
+			EnvFrom:                   src.Spec.APIServer.EnvFrom,
+			Resources:                 src.Spec.APIServer.Resources,
+			ServiceAccountName:        src.Spec.APIServer.ServiceAccountName,
+			PodSecurityContext:        src.Spec.APIServer.PodSecurityContext,
+			SecurityContext:           src.Spec.APIServer.SecurityContext,
+			NodeSelector:              src.Spec.APIServer.NodeSelector,
+			Tolerations:               src.Spec.APIServer.Tolerations,
+			Affinity:                  src.Spec.APIServer.Affinity,
+			TopologySpreadConstraints: src.Spec.APIServer.TopologySpreadConstraints,
+			PriorityClassName:         src.Spec.APIServer.PriorityClassName,
+			RuntimeClassName:          src.Spec.APIServer.RuntimeClassName,
+			Autoscaling:               convertAutoscalingToHub(src.Spec.APIServer.Autoscaling),
+			Authentication:            (*v1beta1.Authentication)(src.Spec.APIServer.Authentication),
+			OAuthProxy:                (*v1beta1.OAuthProxy)(src.Spec.APIServer.OAuthProxy),
+			TLS:                       convertTLSToHub(src.Spec.APIServer.TLS),
+		}
+	}
+
+	if src.Spec.Engine != nil {
+		dst.Spec.Engine = &v1beta1.Engine{}
+		if src.Spec.Engine.Local != nil {
+			dst.Spec.Engine.Type = v1beta1.EngineTypeLocal
+			dst.Spec.Engine.Local = &v1beta1.LocalEngine{NumWorkers: src.Spec.Engine.Local.NumWorkers}
+		}
+		if src.Spec.Engine.KFP != nil {
+			dst.Spec.Engine.Type = v1beta1.EngineTypeKFP
+			dst.Spec.Engine.KFP = &v1beta1.KFPEngine{Endpoint: src.Spec.Engine.KFP.Endpoint}
+		}
+		if rq := src.Spec.Engine.RQ; rq != nil {
+			dst.Spec.Engine.Type = v1beta1.EngineTypeRQ
+			dst.Spec.Engine.RQ = &v1beta1.RQEngine{
+				Redis: v1beta1.Redis{
+					URL:          rq.Redis.URL,
+					URLSecretRef: rq.Redis.URLSecretRef,
+					Managed:      (*v1beta1.ManagedRedis)(rq.Redis.Managed),
+				},
+				Workers: rq.Workers,
+			}
+		}
+	}
+
+	if src.Spec.Route != nil || src.Spec.Ingress != nil || src.Spec.Gateway != nil {
+		dst.Spec.Exposure = &v1beta1.Exposure{}
+		if src.Spec.Route != nil {
+			dst.Spec.Exposure.Route = (*v1beta1.Route)(src.Spec.Route)
+		}
+		if src.Spec.Ingress != nil {
+			dst.Spec.Exposure.Ingress = (*v1beta1.Ingress)(src.Spec.Ingress)
+		}
+		if src.Spec.Gateway != nil {
+			dst.Spec.Exposure.Gateway = (*v1beta1.Gateway)(src.Spec.Gateway)
+		}
+	}
+
+	dst.Spec.NetworkPolicy = (*v1beta1.NetworkPolicy)(src.Spec.NetworkPolicy)
+	dst.Spec.Models = (*v1beta1.Models)(src.Spec.Models)
+	dst.Spec.Worker = (*v1beta1.Worker)(src.Spec.Worker)
+
+	dst.Status = v1beta1.DoclingServeStatus(src.Status)
+
+	return nil
+}
+
+// ConvertFrom converts from the Hub version (v1beta1) to this version.
+func (dst *DoclingServe) ConvertFrom(srcRaw conversion.Hub) error {
+	src, ok := srcRaw.(*v1beta1.DoclingServe)
+	if !ok {
+		return fmt.Errorf("expected a v1beta1 DoclingServe but got %T", srcRaw)
+	}
+
+	dst.ObjectMeta = src.ObjectMeta
+
+	if src.Spec.Workload != nil {
+		dst.Spec.APIServer = &APIServer{
+			Image:                     src.Spec.Workload.Image,
+			EnableUI:                  src.Spec.Workload.EnableUI,
+			Settings:                  (*Settings)(src.Spec.Workload.Settings),
+			Instances:                 src.Spec.Workload.Replicas,
+			ConfigMapName:             src.Spec.Workload.ConfigMapName,
+			Env:                       src.Spec.Workload.Env,
+			EnvFrom:                   src.Spec.Workload.EnvFrom,
+			Resources:                 src.Spec.Workload.Resources,
+			ServiceAccountName:        src.Spec.Workload.ServiceAccountName,
+			PodSecurityContext:        src.Spec.Workload.PodSecurityContext,
+			SecurityContext:           src.Spec.Workload.SecurityContext,
+			NodeSelector:              src.Spec.Workload.NodeSelector,
+			Tolerations:               src.Spec.Workload.Tolerations,
+			Affinity:                  src.Spec.Workload.Affinity,
+			TopologySpreadConstraints: src.Spec.Workload.TopologySpreadConstraints,
+			PriorityClassName:         src.Spec.Workload.PriorityClassName,
+			RuntimeClassName:          src.Spec.Workload.RuntimeClassName,
+			Autoscaling:               convertAutoscalingFromHub(src.Spec.Workload.Autoscaling),
+			Authentication:            (*Authentication)(src.Spec.Workload.Authentication),
+			OAuthProxy:                (*OAuthProxy)(src.Spec.Workload.OAuthProxy),
+			TLS:                       convertTLSFromHub(src.Spec.Workload.TLS),
+		}
+	}
+
+	if src.Spec.Engine != nil {
+		dst.Spec.Engine = &Engine{}
+		switch src.Spec.Engine.Type {
+		case v1beta1.EngineTypeLocal:
+			dst.Spec.Engine.Local = &Local{}
+			if src.Spec.Engine.Local != nil {
+				dst.Spec.Engine.Local.NumWorkers = src.Spec.Engine.Local.NumWorkers
+			}
+		case v1beta1.EngineTypeKFP:
+			dst.Spec.Engine.KFP = &KFP{}
+			if src.Spec.Engine.KFP != nil {
+				dst.Spec.Engine.KFP.Endpoint = src.Spec.Engine.KFP.Endpoint
+			}
+		case v1beta1.EngineTypeRQ:
+			dst.Spec.Engine.RQ = &RQ{}
+			if rq := src.Spec.Engine.RQ; rq != nil {
+				dst.Spec.Engine.RQ.Redis = Redis{
+					URL:          rq.Redis.URL,
+					URLSecretRef: rq.Redis.URLSecretRef,
+					Managed:      (*ManagedRedis)(rq.Redis.Managed),
+				}
+				dst.Spec.Engine.RQ.Workers = rq.Workers
+			}
+		}
+	}
+
+	if src.Spec.Exposure != nil {
+		dst.Spec.Route = (*Route)(src.Spec.Exposure.Route)
+		dst.Spec.Ingress = (*Ingress)(src.Spec.Exposure.Ingress)
+		dst.Spec.Gateway = (*Gateway)(src.Spec.Exposure.Gateway)
+	}
+
+	dst.Spec.NetworkPolicy = (*NetworkPolicy)(src.Spec.NetworkPolicy)
+	dst.Spec.Models = (*Models)(src.Spec.Models)
+	dst.Spec.Worker = (*Worker)(src.Spec.Worker)
+
+	dst.Status = DoclingServeStatus(src.Status)
+
+	return nil
+}
+
+func convertAutoscalingToHub(src *Autoscaling) *v1beta1.Autoscaling {
+	if src == nil {
+		return nil
+	}
+	dst := &v1beta1.Autoscaling{
+		Enabled:                           src.Enabled,
+		Mode:                              src.Mode,
+		MinReplicas:                       src.MinReplicas,
+		MaxReplicas:                       src.MaxReplicas,
+		TargetCPUUtilizationPercentage:    src.TargetCPUUtilizationPercentage,
+		TargetMemoryUtilizationPercentage: src.TargetMemoryUtilizationPercentage,
+		Behavior:                          src.Behavior,
+	}
+	if src.KEDA != nil {
+		dst.KEDA = &v1beta1.KEDAAutoscaling{
+			Prometheus:      (*v1beta1.PrometheusTrigger)(src.KEDA.Prometheus),
+			MetricsAPI:      (*v1beta1.MetricsAPITrigger)(src.KEDA.MetricsAPI),
+			PollingInterval: src.KEDA.PollingInterval,
+			CooldownPeriod:  src.KEDA.CooldownPeriod,
+		}
+	}
+	return dst
+}
+
+func convertAutoscalingFromHub(src *v1beta1.Autoscaling) *Autoscaling {
+	if src == nil {
+		return nil
+	}
+	dst := &Autoscaling{
+		Enabled:                           src.Enabled,
+		Mode:                              src.Mode,
+		MinReplicas:                       src.MinReplicas,
+		MaxReplicas:                       src.MaxReplicas,
+		TargetCPUUtilizationPercentage:    src.TargetCPUUtilizationPercentage,
+		TargetMemoryUtilizationPercentage: src.TargetMemoryUtilizationPercentage,
+		Behavior:                          src.Behavior,
+	}
+	if src.KEDA != nil {
+		dst.KEDA = &KEDAAutoscaling{
+			Prometheus:      (*PrometheusTrigger)(src.KEDA.Prometheus),
+			MetricsAPI:      (*MetricsAPITrigger)(src.KEDA.MetricsAPI),
+			PollingInterval: src.KEDA.PollingInterval,
+			CooldownPeriod:  src.KEDA.CooldownPeriod,
+		}
+	}
+	return dst
+}
+
+func convertTLSToHub(src *TLS) *v1beta1.TLS {
+	if src == nil {
+		return nil
+	}
+	return &v1beta1.TLS{
+		Enabled:     src.Enabled,
+		Provider:    src.Provider,
+		CertManager: (*v1beta1.CertManagerTLS)(src.CertManager),
+	}
+}
+
+func convertTLSFromHub(src *v1beta1.TLS) *TLS {
+	if src == nil {
+		return nil
+	}
+	return &TLS{
+		Enabled:     src.Enabled,
+		Provider:    src.Provider,
+		CertManager: (*CertManagerTLS)(src.CertManager),
+	}
+}
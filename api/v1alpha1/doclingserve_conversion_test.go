@@ -0,0 +1,188 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.io/docling-project/docling-operator/api/v1beta1"
+)
+
+var _ = Describe("DoclingServe Conversion", func() {
+	var obj *DoclingServe
+
+	BeforeEach(func() {
+		obj = &DoclingServe{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "test-resource",
+				Namespace:   "default",
+				Labels:      map[string]string{"app": "docling"},
+				Annotations: map[string]string{"note": "kept"},
+			},
+			Spec: DoclingServeSpec{
+				APIServer: &APIServer{
+					Image:    "quay.io/docling-project/docling-serve:v1.0.0",
+					EnableUI: true,
+					Settings: &Settings{
+						MaxSyncWait:        ptr.To(int32(300)),
+						MaxFileSize:        ptr.To(resource.MustParse("100Mi")),
+						ResultRemovalDelay: &metav1.Duration{Duration: 10 * time.Minute},
+						CORSOrigins:        []string{"https://docling.example.com"},
+					},
+					Instances:          3,
+					ConfigMapName:      "docling-config",
+					Env:                []corev1.EnvVar{{Name: "DOCLING_SERVE_MAX_NUM_PAGES", Value: "100"}},
+					EnvFrom:            []corev1.EnvFromSource{{SecretRef: &corev1.SecretEnvSource{LocalObjectReference: corev1.LocalObjectReference{Name: "docling-tokens"}}}},
+					ServiceAccountName: "docling-identity",
+					PodSecurityContext: &corev1.PodSecurityContext{FSGroup: ptr.To(int64(1001))},
+					SecurityContext:    &corev1.SecurityContext{ReadOnlyRootFilesystem: ptr.To(true)},
+					NodeSelector:       map[string]string{"node-pool": "docling"},
+					Tolerations:        []corev1.Toleration{{Key: "dedicated", Operator: corev1.TolerationOpExists}},
+					PriorityClassName:  "docling-high",
+					RuntimeClassName:   ptr.To("kata"),
+					Resources: &corev1.ResourceRequirements{
+						Limits: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("4Gi")},
+					},
+					Autoscaling: &Autoscaling{
+						Enabled:                        true,
+						MinReplicas:                    ptr.To(int32(2)),
+						MaxReplicas:                    5,
+						TargetCPUUtilizationPercentage: ptr.To(int32(70)),
+						KEDA: &KEDAAutoscaling{
+							MetricsAPI: &MetricsAPITrigger{
+								URL:           "http://docling-stats.default.svc:8080/stats",
+								ValueLocation: "tasks.pending",
+								TargetValue:   "3",
+							},
+							CooldownPeriod: ptr.To(int32(300)),
+						},
+					},
+					Authentication: &Authentication{
+						Enabled:    true,
+						SecretName: "docling-api-key",
+						SecretKey:  "token",
+					},
+					OAuthProxy: &OAuthProxy{
+						Enabled: true,
+						Image:   "quay.io/openshift/origin-oauth-proxy:4.16",
+					},
+					TLS: &TLS{
+						Enabled:     true,
+						Provider:    "CertManager",
+						CertManager: &CertManagerTLS{IssuerName: "docling-ca", IssuerKind: "ClusterIssuer"},
+					},
+				},
+				Engine: &Engine{Local: &Local{NumWorkers: 4}},
+				Route: &Route{
+					Enabled:     true,
+					Host:        "docling.example.com",
+					Path:        "/",
+					Termination: "reencrypt",
+					Timeout:     "300s",
+					Annotations: map[string]string{"haproxy.router.openshift.io/balance": "roundrobin"},
+				},
+				Ingress: &Ingress{Enabled: true, Host: "docling.example.com", Path: "/api"},
+				Gateway: &Gateway{Enabled: true, Name: "shared", Hostnames: []string{"docling.example.com"}},
+				Models: &Models{
+					Enabled:          true,
+					CacheName:        "shared",
+					Image:            "registry.example.com/docling-models:v1",
+					ImagePath:        "/models",
+					ImageMountMode:   "ImageVolume",
+					StorageClassName: ptr.To("fast"),
+					Size:             ptr.To(resource.MustParse("20Gi")),
+					AccessMode:       corev1.ReadWriteMany,
+					Bundles:          []string{"layout"},
+				},
+				NetworkPolicy: &NetworkPolicy{
+					Mode:           "allowFrom",
+					From:           []networkingv1.NetworkPolicyPeer{{PodSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "client"}}}},
+					RestrictEgress: true,
+				},
+			},
+			Status: DoclingServeStatus{
+				Conditions:         []metav1.Condition{{Type: "DeploymentCreated", Status: metav1.ConditionTrue, Reason: "DeploymentCreated"}},
+				ObservedGeneration: 2,
+				Replicas:           3,
+				Selector:           "app=docling-serve,doclingserve_cr=test-resource",
+			},
+		}
+	})
+
+	It("Should round-trip a local engine resource through the hub", func() {
+		hub := &v1beta1.DoclingServe{}
+		Expect(obj.ConvertTo(hub)).To(Succeed())
+		Expect(hub.Spec.Engine.Type).To(Equal(v1beta1.EngineTypeLocal))
+		Expect(hub.Spec.Workload.Replicas).To(Equal(int32(3)))
+		Expect(hub.Spec.Exposure.Route.Termination).To(Equal("reencrypt"))
+
+		restored := &DoclingServe{}
+		Expect(restored.ConvertFrom(hub)).To(Succeed())
+		Expect(restored).To(Equal(obj))
+	})
+
+	It("Should round-trip a KFP engine resource without exposure through the hub", func() {
+		obj.Spec.Engine = &Engine{KFP: &KFP{Endpoint: "https://kfp.example.com:8888"}}
+		obj.Spec.Route, obj.Spec.Ingress, obj.Spec.Gateway = nil, nil, nil
+
+		hub := &v1beta1.DoclingServe{}
+		Expect(obj.ConvertTo(hub)).To(Succeed())
+		Expect(hub.Spec.Engine.Type).To(Equal(v1beta1.EngineTypeKFP))
+		Expect(hub.Spec.Engine.Local).To(BeNil())
+		Expect(hub.Spec.Exposure).To(BeNil())
+
+		restored := &DoclingServe{}
+		Expect(restored.ConvertFrom(hub)).To(Succeed())
+		Expect(restored).To(Equal(obj))
+	})
+
+	It("Should round-trip an RQ engine resource through the hub", func() {
+		obj.Spec.Engine = &Engine{RQ: &RQ{Workers: 2, Redis: Redis{Managed: &ManagedRedis{Image: "quay.io/sclorg/redis-7-c9s:latest"}}}}
+		obj.Spec.Worker = &Worker{NodeSelector: map[string]string{"node-role.kubernetes.io/worker": ""}}
+		obj.Status.WorkerReplicas = 2
+
+		hub := &v1beta1.DoclingServe{}
+		Expect(obj.ConvertTo(hub)).To(Succeed())
+		Expect(hub.Spec.Engine.Type).To(Equal(v1beta1.EngineTypeRQ))
+		Expect(hub.Spec.Engine.RQ.Redis.Managed.Image).To(Equal("quay.io/sclorg/redis-7-c9s:latest"))
+		Expect(hub.Spec.Worker.NodeSelector).To(HaveKey("node-role.kubernetes.io/worker"))
+		Expect(hub.Status.WorkerReplicas).To(Equal(int32(2)))
+
+		restored := &DoclingServe{}
+		Expect(restored.ConvertFrom(hub)).To(Succeed())
+		Expect(restored).To(Equal(obj))
+	})
+
+	It("Should round-trip a hub resource through v1alpha1", func() {
+		hub := &v1beta1.DoclingServe{}
+		Expect(obj.ConvertTo(hub)).To(Succeed())
+
+		spoke := &DoclingServe{}
+		Expect(spoke.ConvertFrom(hub)).To(Succeed())
+		restored := &v1beta1.DoclingServe{}
+		Expect(spoke.ConvertTo(restored)).To(Succeed())
+		Expect(restored).To(Equal(hub))
+	})
+})
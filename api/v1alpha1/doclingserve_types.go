@@ -17,13 +17,17 @@ limitations under the License.
 package v1alpha1
 
 import (
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	v1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 // NOTE: json tags are required.  Any new fields you add must have json tags for the fields to be serialized.
 
 // DoclingServeSpec defines the desired state of DoclingServe.
+// +kubebuilder:validation:XValidation:rule="!has(self.worker) || (has(self.engine) && has(self.engine.rq))", message="worker can only be configured for the RQ engine"
 type DoclingServeSpec struct {
 	// +kubebuilder:validation:Required
 	APIServer *APIServer `json:"apiServer"`
@@ -33,6 +37,53 @@ type DoclingServeSpec struct {
 
 	// +kubebuilder:validation:Optional,name="Route"
 	Route *Route `json:"route,omitempty"`
+
+	// +kubebuilder:validation:Optional,name="Ingress"
+	Ingress *Ingress `json:"ingress,omitempty"`
+
+	// +kubebuilder:validation:Optional,name="Gateway"
+	Gateway *Gateway `json:"gateway,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	NetworkPolicy *NetworkPolicy `json:"networkPolicy,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	Models *Models `json:"models,omitempty"`
+
+	// Worker configures the worker pods of the RQ engine, apart from the docling-serve pods serving the API.
+	// +kubebuilder:validation:Optional
+	Worker *Worker `json:"worker,omitempty"`
+}
+
+// Worker configures the pods of the RQ workers running the conversions queued by the docling-serve pods. The number
+// of workers is set by the RQ engine. The fields that are not set take the value of the docling-serve pods.
+type Worker struct {
+	// Resources of the worker container. The conversions run in the workers, the docling-serve pods only queue them.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Worker Resources",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:resourceRequirements"}
+	// +kubebuilder:validation:Optional
+	Resources *v1.ResourceRequirements `json:"resources,omitempty"`
+
+	// NodeSelector restricts the worker pods to the nodes with these labels.
+	// +kubebuilder:validation:Optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+
+	// Tolerations let the worker pods run on tainted nodes, such as a dedicated GPU node pool.
+	// +kubebuilder:validation:Optional
+	Tolerations []v1.Toleration `json:"tolerations,omitempty"`
+
+	// Affinity constrains the nodes the worker pods run on. When it is not set and more than one worker runs,
+	// the workers prefer to run on different nodes.
+	// +kubebuilder:validation:Optional
+	Affinity *v1.Affinity `json:"affinity,omitempty"`
+
+	// TopologySpreadConstraints spread the worker pods across zones or nodes. A constraint without a label selector
+	// selects the worker pods of the DoclingServe.
+	// +kubebuilder:validation:Optional
+	TopologySpreadConstraints []v1.TopologySpreadConstraint `json:"topologySpreadConstraints,omitempty"`
+
+	// PriorityClassName is the priority class of the worker pods.
+	// +kubebuilder:validation:Optional
+	PriorityClassName string `json:"priorityClassName,omitempty"`
 }
 
 // APIServer configures a docling-serve workload
@@ -40,7 +91,7 @@ type APIServer struct {
 	// Image specifics which docling-serve container image to deploy.
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Image",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
 	// +kubebuilder:validation:Required
-	// +kubebuilder:default="quay.io/docling-project/docling-serve:latest"
+	// +kubebuilder:default="quay.io/docling-project/docling-serve:v1.0.0"
 	Image string `json:"image"`
 
 	// EnableUI determines whether to run the docling-serve ui.
@@ -48,9 +99,14 @@ type APIServer struct {
 	// +kubebuilder:validation:Optional
 	EnableUI bool `json:"enableUI,omitempty"`
 
+	// Settings configures docling-serve with typed values instead of raw environment variables.
+	// +kubebuilder:validation:Optional
+	Settings *Settings `json:"settings,omitempty"`
+
 	// Instances represents the desired number of docling-serve workloads to create.
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Instance Count",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:podCount"}
 	// +kubebuilder:default=1
+	// +kubebuilder:validation:Minimum=0
 	Instances int32 `json:"instances,omitempty"`
 
 	// ConfigMapName represents the config map name that contains additional configurations.
@@ -58,29 +114,482 @@ type APIServer struct {
 	// +kubebuilder:validation:Optional
 	ConfigMapName string `json:"configMapName,omitempty"`
 
+	// Env sets environment variables of the docling-serve container, from literal values or from references to
+	// secrets, config maps and fields. They take precedence over envFrom and configMapName. The variables managed
+	// by the operator cannot be set.
+	// +kubebuilder:validation:Optional
+	Env []v1.EnvVar `json:"env,omitempty"`
+
+	// EnvFrom sets environment variables of the docling-serve container from config maps and secrets. They are
+	// loaded after configMapName, a key defined in several sources takes the value of the last one.
+	// +kubebuilder:validation:Optional
+	EnvFrom []v1.EnvFromSource `json:"envFrom,omitempty"`
+
 	// Resources
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Resources",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:resourceRequirements"}
 	// +kubebuilder:validation:Optional
 	Resources *v1.ResourceRequirements `json:"resources,omitempty"`
+
+	// ServiceAccountName references an existing service account to run the docling-serve pods with.
+	// The operator creates and owns a <name>-sa service account when it is empty.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Service Account Name",xDescriptors={"urn:alm:descriptor:io.kubernetes:ServiceAccount"}
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MaxLength=253
+	ServiceAccountName string `json:"serviceAccountName,omitempty"`
+
+	// PodSecurityContext overrides fields of the restricted security context the docling-serve pods run with.
+	// +kubebuilder:validation:Optional
+	PodSecurityContext *v1.PodSecurityContext `json:"podSecurityContext,omitempty"`
+
+	// SecurityContext overrides fields of the restricted security context of the docling-serve container.
+	// Enabling readOnlyRootFilesystem mounts writable emptyDir volumes for the temporary and cache directories.
+	// +kubebuilder:validation:Optional
+	SecurityContext *v1.SecurityContext `json:"securityContext,omitempty"`
+
+	// NodeSelector restricts the docling-serve pods to the nodes with these labels.
+	// +kubebuilder:validation:Optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+
+	// Tolerations let the docling-serve pods run on tainted nodes, such as a dedicated node pool.
+	// +kubebuilder:validation:Optional
+	Tolerations []v1.Toleration `json:"tolerations,omitempty"`
+
+	// Affinity constrains the nodes the docling-serve pods run on. When it is not set and more than one pod may run,
+	// the pods prefer to run on different nodes.
+	// +kubebuilder:validation:Optional
+	Affinity *v1.Affinity `json:"affinity,omitempty"`
+
+	// TopologySpreadConstraints spread the docling-serve pods across zones or nodes. A constraint without a label
+	// selector selects the pods of the DoclingServe.
+	// +kubebuilder:validation:Optional
+	TopologySpreadConstraints []v1.TopologySpreadConstraint `json:"topologySpreadConstraints,omitempty"`
+
+	// PriorityClassName is the priority class of the docling-serve pods.
+	// +kubebuilder:validation:Optional
+	PriorityClassName string `json:"priorityClassName,omitempty"`
+
+	// RuntimeClassName is the runtime class the docling-serve pods run with.
+	// +kubebuilder:validation:Optional
+	RuntimeClassName *string `json:"runtimeClassName,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	Autoscaling *Autoscaling `json:"autoscaling,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	Authentication *Authentication `json:"authentication,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	OAuthProxy *OAuthProxy `json:"oauthProxy,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	TLS *TLS `json:"tls,omitempty"`
+}
+
+// Settings configures docling-serve. Each setting is passed to docling-serve as the environment variable it reads,
+// and is only accepted when the image is recent enough to read it.
+type Settings struct {
+	// MaxSyncWait is the number of seconds a synchronous conversion waits for its result (DOCLING_SERVE_MAX_SYNC_WAIT).
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Max Sync Wait",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:number"}
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:default=120
+	MaxSyncWait *int32 `json:"maxSyncWait,omitempty"`
+
+	// MaxNumPages is the largest number of pages of a converted document (DOCLING_SERVE_MAX_NUM_PAGES).
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	MaxNumPages *int32 `json:"maxNumPages,omitempty"`
+
+	// MaxFileSize is the largest size of a converted document (DOCLING_SERVE_MAX_FILE_SIZE).
+	// +kubebuilder:validation:Optional
+	MaxFileSize *resource.Quantity `json:"maxFileSize,omitempty"`
+
+	// ResultRemovalDelay is how long the results of asynchronous conversions are kept after they have been fetched
+	// (DOCLING_SERVE_RESULT_REMOVAL_DELAY).
+	// +kubebuilder:validation:Optional
+	ResultRemovalDelay *metav1.Duration `json:"resultRemovalDelay,omitempty"`
+
+	// EnableRemoteServices allows the conversions to call remote services, such as remote vision models
+	// (DOCLING_SERVE_ENABLE_REMOTE_SERVICES).
+	// +kubebuilder:validation:Optional
+	EnableRemoteServices *bool `json:"enableRemoteServices,omitempty"`
+
+	// AllowedOCREngines restricts the OCR engines the conversions may use (DOCLING_SERVE_ALLOWED_OCR_ENGINES).
+	// +kubebuilder:validation:Optional
+	// +listType=set
+	// +kubebuilder:validation:items:Pattern=`^(auto|easyocr|rapidocr|tesseract|tesserocr)$`
+	AllowedOCREngines []string `json:"allowedOCREngines,omitempty"`
+
+	// CORSOrigins lists the origins allowed to call the API from a browser (DOCLING_SERVE_CORS_ORIGINS).
+	// +kubebuilder:validation:Optional
+	CORSOrigins []string `json:"corsOrigins,omitempty"`
+
+	// LogLevel is the level of the server logs (UVICORN_LOG_LEVEL).
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=critical;error;warning;info;debug;trace
+	LogLevel string `json:"logLevel,omitempty"`
+
+	// ArtifactsPath is the directory docling-serve loads its models from (DOCLING_SERVE_ARTIFACTS_PATH).
+	// +kubebuilder:validation:Optional
+	ArtifactsPath string `json:"artifactsPath,omitempty"`
+}
+
+// TLS serves docling-serve over HTTPS inside the cluster, with a certificate for its service.
+// +kubebuilder:validation:XValidation:rule="!has(self.provider) || self.provider != 'CertManager' || has(self.certManager)", message="certManager must be configured for the CertManager provider"
+type TLS struct {
+	// Enabled determines whether docling-serve serves HTTPS. Routes then re-encrypt the traffic to the pods.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Enable TLS",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:booleanSwitch"}
+	// +kubebuilder:validation:Optional
+	Enabled bool `json:"enabled,omitempty"`
+
+	// Provider issues the serving certificate: the OpenShift service CA (ServiceCA) or cert-manager (CertManager).
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Certificate Provider",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:select:ServiceCA","urn:alm:descriptor:com.tectonic.ui:select:CertManager"}
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=ServiceCA;CertManager
+	// +kubebuilder:default=ServiceCA
+	Provider string `json:"provider,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	CertManager *CertManagerTLS `json:"certManager,omitempty"`
+}
+
+// CertManagerTLS selects the cert-manager issuer signing the serving certificate.
+type CertManagerTLS struct {
+	// IssuerName is the name of the issuer.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Issuer Name",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	IssuerName string `json:"issuerName"`
+
+	// IssuerKind is the kind of the issuer, Issuer or ClusterIssuer.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Issuer Kind",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=Issuer
+	IssuerKind string `json:"issuerKind,omitempty"`
+
+	// IssuerGroup is the API group of the issuer, for external issuers.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Issuer Group",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="cert-manager.io"
+	IssuerGroup string `json:"issuerGroup,omitempty"`
+}
+
+// OAuthProxy puts the OpenShift OAuth proxy in front of the docling-serve UI and API.
+// Only the users allowed to get the DoclingServe resource are let through. The proxy runs with the service account
+// created by the operator, it cannot be combined with a serviceAccountName.
+type OAuthProxy struct {
+	// Enabled determines whether to inject the OAuth proxy sidecar. The service and the route then only expose the proxy.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Enable OAuth Proxy",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:booleanSwitch"}
+	// +kubebuilder:validation:Optional
+	Enabled bool `json:"enabled,omitempty"`
+
+	// Image specifies the OAuth proxy container image.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="OAuth Proxy Image",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="quay.io/openshift/origin-oauth-proxy:4.17"
+	Image string `json:"image,omitempty"`
+
+	// Resources of the OAuth proxy container.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="OAuth Proxy Resources",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:resourceRequirements"}
+	// +kubebuilder:validation:Optional
+	Resources *v1.ResourceRequirements `json:"resources,omitempty"`
+}
+
+// Authentication protects the docling-serve API with an API key, which clients send in the X-Api-Key header.
+// +kubebuilder:validation:XValidation:rule="!has(self.secretName) || !has(self.rotationInterval)", message="Only the generated API key secret can be rotated"
+type Authentication struct {
+	// Enabled determines whether docling-serve requires an API key.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Enable Authentication",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:booleanSwitch"}
+	// +kubebuilder:validation:Optional
+	Enabled bool `json:"enabled,omitempty"`
+
+	// SecretName references an existing secret holding the API key.
+	// The operator generates a random API key in a <name>-api-key secret when it is empty.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="API Key Secret",xDescriptors={"urn:alm:descriptor:io.kubernetes:Secret"}
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MaxLength=253
+	SecretName string `json:"secretName,omitempty"`
+
+	// SecretKey is the key of the secret holding the API key.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="API Key Secret Key",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="api-key"
+	SecretKey string `json:"secretKey,omitempty"`
+
+	// RotationInterval is how often the operator replaces the generated API key, e.g. 720h.
+	// The docling-serve pods are restarted with the new key; the key is never rotated when it is not set.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Rotation Interval",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	// +kubebuilder:validation:Optional
+	RotationInterval *metav1.Duration `json:"rotationInterval,omitempty"`
+}
+
+// Autoscaling configures an autoscaler for the docling-serve pods.
+// +kubebuilder:validation:XValidation:rule="!has(self.minReplicas) || self.minReplicas <= self.maxReplicas", message="minReplicas must not be greater than maxReplicas"
+// +kubebuilder:validation:XValidation:rule="!has(self.minReplicas) || self.minReplicas >= 1 || (has(self.mode) && self.mode == 'KEDA')", message="Only the KEDA mode can scale to zero replicas"
+// +kubebuilder:validation:XValidation:rule="!has(self.mode) || self.mode != 'KEDA' || has(self.keda)", message="keda must be configured for the KEDA mode"
+type Autoscaling struct {
+	// Enabled determines whether to create an autoscaler. The replica count of the workload is left to the autoscaler while enabled.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Enable Autoscaling",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:booleanSwitch"}
+	// +kubebuilder:validation:Optional
+	Enabled bool `json:"enabled,omitempty"`
+
+	// Mode selects the autoscaler: a HorizontalPodAutoscaler on the CPU and memory utilization (Resource),
+	// or a KEDA ScaledObject on the depth of the docling-serve task queue (KEDA).
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Autoscaling Mode",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:select:Resource","urn:alm:descriptor:com.tectonic.ui:select:KEDA"}
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=Resource;KEDA
+	// +kubebuilder:default=Resource
+	Mode string `json:"mode,omitempty"`
+
+	// MinReplicas is the lower limit for the number of docling-serve pods. The KEDA mode scales to zero when it is 0.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Minimum Replicas",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:podCount"}
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:default=1
+	MinReplicas *int32 `json:"minReplicas,omitempty"`
+
+	// MaxReplicas is the upper limit for the number of docling-serve pods.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Maximum Replicas",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:podCount"}
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Minimum=1
+	MaxReplicas int32 `json:"maxReplicas"`
+
+	// TargetCPUUtilizationPercentage is the target average CPU utilization, relative to the requested CPU, of the Resource mode.
+	// It defaults to 80 when no target is configured.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Target CPU Utilization",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:number"}
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	TargetCPUUtilizationPercentage *int32 `json:"targetCPUUtilizationPercentage,omitempty"`
+
+	// TargetMemoryUtilizationPercentage is the target average memory utilization, relative to the requested memory, of the Resource mode.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Target Memory Utilization",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:number"}
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	TargetMemoryUtilizationPercentage *int32 `json:"targetMemoryUtilizationPercentage,omitempty"`
+
+	// Behavior configures the scaling behavior in the up and down directions.
+	// +kubebuilder:validation:Optional
+	Behavior *autoscalingv2.HorizontalPodAutoscalerBehavior `json:"behavior,omitempty"`
+
+	// KEDA configures the trigger of the KEDA mode.
+	// +kubebuilder:validation:Optional
+	KEDA *KEDAAutoscaling `json:"keda,omitempty"`
+}
+
+// KEDAAutoscaling configures a KEDA ScaledObject scaling docling-serve on the depth of its task queue.
+// +kubebuilder:validation:XValidation:rule="has(self.prometheus) != has(self.metricsAPI)", message="Exactly one of prometheus or metricsAPI must be configured"
+type KEDAAutoscaling struct {
+	// Prometheus scales on the result of a Prometheus query over the docling-serve queue metrics.
+	// +kubebuilder:validation:Optional
+	Prometheus *PrometheusTrigger `json:"prometheus,omitempty"`
+
+	// MetricsAPI scales on a value read from a JSON endpoint, e.g. the docling-serve task statistics.
+	// +kubebuilder:validation:Optional
+	MetricsAPI *MetricsAPITrigger `json:"metricsAPI,omitempty"`
+
+	// PollingInterval is the interval in seconds at which the trigger is checked.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Polling Interval",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:number"}
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	PollingInterval *int32 `json:"pollingInterval,omitempty"`
+
+	// CooldownPeriod is the number of seconds to wait after the last active trigger before scaling to minReplicas when it is 0.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Cooldown Period",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:number"}
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=0
+	CooldownPeriod *int32 `json:"cooldownPeriod,omitempty"`
+}
+
+// PrometheusTrigger configures a KEDA prometheus trigger.
+type PrometheusTrigger struct {
+	// ServerAddress is the URL of the Prometheus server, e.g. https://thanos-querier.openshift-monitoring.svc.cluster.local:9092.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Prometheus Server Address",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^https?://`
+	ServerAddress string `json:"serverAddress"`
+
+	// Query returns the number of pending docling-serve tasks.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Query",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Query string `json:"query"`
+
+	// Threshold is the number of pending tasks per pod the autoscaler aims for.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Threshold",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	// +kubebuilder:validation:Required
+	Threshold string `json:"threshold"`
+
+	// ActivationThreshold is the number of pending tasks above which docling-serve is scaled up from zero.
+	// +kubebuilder:validation:Optional
+	ActivationThreshold string `json:"activationThreshold,omitempty"`
+
+	// AuthenticationRef is the name of a KEDA TriggerAuthentication in the namespace used to authenticate against Prometheus.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Trigger Authentication",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	// +kubebuilder:validation:Optional
+	AuthenticationRef string `json:"authenticationRef,omitempty"`
+
+	// AuthModes lists the authentication types of the TriggerAuthentication, e.g. bearer or basic. It defaults to bearer.
+	// +kubebuilder:validation:Optional
+	AuthModes string `json:"authModes,omitempty"`
+}
+
+// MetricsAPITrigger configures a KEDA metrics-api trigger.
+type MetricsAPITrigger struct {
+	// URL of the JSON endpoint reporting the pending docling-serve tasks.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="URL",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^https?://`
+	URL string `json:"url"`
+
+	// ValueLocation is the GJSON path of the number of pending tasks in the response, e.g. tasks.pending.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Value Location",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	// +kubebuilder:validation:Required
+	ValueLocation string `json:"valueLocation"`
+
+	// TargetValue is the number of pending tasks per pod the autoscaler aims for.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Target Value",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	// +kubebuilder:validation:Required
+	TargetValue string `json:"targetValue"`
+
+	// ActivationTargetValue is the number of pending tasks above which docling-serve is scaled up from zero.
+	// +kubebuilder:validation:Optional
+	ActivationTargetValue string `json:"activationTargetValue,omitempty"`
+
+	// AuthenticationRef is the name of a KEDA TriggerAuthentication in the namespace used to authenticate against the endpoint.
+	// +kubebuilder:validation:Optional
+	AuthenticationRef string `json:"authenticationRef,omitempty"`
 }
 
 // Route configures an OpenShift route, exposed Docling API outside the cluster.
+// +kubebuilder:validation:XValidation:rule="!(has(self.host) && has(self.subdomain))", message="Only one of host or subdomain can be configured"
+// +kubebuilder:validation:XValidation:rule="!(has(self.termination) && self.termination == 'passthrough' && has(self.insecureEdgeTerminationPolicy) && self.insecureEdgeTerminationPolicy == 'Allow')", message="Passthrough routes do not support the Allow insecure edge termination policy"
 type Route struct {
 	// Enabled determines whether to create a route.
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Enable Route",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:booleanSwitch"}
 	// +kubebuilder:validation:Optional
 	Enabled bool `json:"enabled,omitempty"`
+
+	// Host is the fully qualified domain name of the route. The router generates a host when both host and subdomain are empty.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Host",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	// +kubebuilder:validation:Optional
+	Host string `json:"host,omitempty"`
+
+	// Subdomain is a DNS subdomain that is combined with the router's ingress domain to form the route host.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Subdomain",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	// +kubebuilder:validation:Optional
+	Subdomain string `json:"subdomain,omitempty"`
+
+	// Path is the URL path prefix forwarded to docling-serve.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Path",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern=`^/`
+	// +kubebuilder:default="/"
+	Path string `json:"path,omitempty"`
+
+	// Termination selects where TLS is terminated: at the router (edge), at the router and again at the pod (reencrypt), or only at the pod (passthrough).
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="TLS Termination",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:select:edge","urn:alm:descriptor:com.tectonic.ui:select:reencrypt","urn:alm:descriptor:com.tectonic.ui:select:passthrough"}
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=edge;reencrypt;passthrough
+	// +kubebuilder:default=edge
+	Termination string `json:"termination,omitempty"`
+
+	// InsecureEdgeTerminationPolicy defines how plain HTTP requests are handled: rejected (None), served (Allow) or redirected to HTTPS (Redirect).
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Insecure Edge Termination Policy",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:select:None","urn:alm:descriptor:com.tectonic.ui:select:Allow","urn:alm:descriptor:com.tectonic.ui:select:Redirect"}
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=None;Allow;Redirect
+	InsecureEdgeTerminationPolicy string `json:"insecureEdgeTerminationPolicy,omitempty"`
+
+	// TLSSecretName is the name of a Secret holding the route certificate. The keys tls.crt, tls.key and ca.crt are copied
+	// into the route, as well as destination-ca.crt for reencrypt termination. The router's default certificate is used when empty.
+	// The route is updated whenever the Secret changes.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="TLS Secret Name",xDescriptors={"urn:alm:descriptor:io.kubernetes:Secret"}
+	// +kubebuilder:validation:Optional
+	TLSSecretName string `json:"tlsSecretName,omitempty"`
+
+	// Timeout is the router timeout for requests to docling-serve, e.g. 300s or 10m. It defaults to docling-serve's max sync
+	// wait, so that synchronous conversions of large documents are not cut off by the router's 30s default. It cannot be
+	// combined with a haproxy.router.openshift.io/timeout annotation, which otherwise takes precedence.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Timeout",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern=`^[0-9]+(us|ms|s|m|h|d)$`
+	Timeout string `json:"timeout,omitempty"`
+
+	// Annotations are added to the route, e.g. to configure the OpenShift router. Annotations set by others are left untouched.
+	// +kubebuilder:validation:Optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// Ingress configures a Kubernetes Ingress, exposing the Docling API outside the cluster.
+type Ingress struct {
+	// Enabled determines whether to create an ingress.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Enable Ingress",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:booleanSwitch"}
+	// +kubebuilder:validation:Optional
+	Enabled bool `json:"enabled,omitempty"`
+
+	// IngressClassName is the name of the IngressClass handling the ingress. The cluster default class is used when empty.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Ingress Class Name",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	// +kubebuilder:validation:Optional
+	IngressClassName string `json:"ingressClassName,omitempty"`
+
+	// Host is the fully qualified domain name the ingress serves. All hosts are matched when empty.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Host",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	// +kubebuilder:validation:Optional
+	Host string `json:"host,omitempty"`
+
+	// Path is the URL path prefix forwarded to docling-serve.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Path",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern=`^/`
+	// +kubebuilder:default="/"
+	Path string `json:"path,omitempty"`
+
+	// TLSSecretName is the name of a kubernetes.io/tls Secret used to terminate TLS for the host.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="TLS Secret Name",xDescriptors={"urn:alm:descriptor:io.kubernetes:Secret"}
+	// +kubebuilder:validation:Optional
+	TLSSecretName string `json:"tlsSecretName,omitempty"`
+
+	// Annotations are added to the ingress, e.g. to configure the ingress controller.
+	// +kubebuilder:validation:Optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// Gateway configures a Gateway API HTTPRoute, attaching the Docling API to an existing Gateway.
+type Gateway struct {
+	// Enabled determines whether to create an HTTPRoute.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Enable Gateway HTTPRoute",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:booleanSwitch"}
+	// +kubebuilder:validation:Optional
+	Enabled bool `json:"enabled,omitempty"`
+
+	// Name of the parent Gateway the HTTPRoute attaches to.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Gateway Name",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+
+	// Namespace of the parent Gateway. Defaults to the namespace of the DoclingServe.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Gateway Namespace",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	// +kubebuilder:validation:Optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// SectionName selects a single listener of the parent Gateway. All listeners are used when empty.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Gateway Listener",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	// +kubebuilder:validation:Optional
+	SectionName string `json:"sectionName,omitempty"`
+
+	// Hostnames matched by the HTTPRoute. The hostnames of the Gateway listener are used when empty.
+	// +kubebuilder:validation:Optional
+	Hostnames []string `json:"hostnames,omitempty"`
 }
 
-// The below Engine struct has XValidation logic that is written to provide mutual exclusivity between `Local` and `KFP` structs.
+// The below Engine struct has XValidation logic that is written to provide mutual exclusivity between the `Local`, `KFP` and `RQ` structs.
 // Currently, K8s' CEL implementation does not support `OneOf` logic. When the below issue is implemented, we can simplify the logic to be `OneOf`
 // https://github.com/kubernetes-sigs/controller-tools/issues/461
 
 // Engine defines which type of docling-serve compute engine to deploy. The selected engine will run all the async jobs.
-// +kubebuilder:validation:XValidation:rule="(has(self.local) && !has(self.kfp)) || (!has(self.local) && has(self.kfp))", message="Only a Local or KFP Engine are allowed to be configured not both"
+// +kubebuilder:validation:XValidation:rule="(has(self.local) ? 1 : 0) + (has(self.kfp) ? 1 : 0) + (has(self.rq) ? 1 : 0) == 1", message="Only one of the Local, KFP or RQ Engines is allowed to be configured"
 type Engine struct {
 	Local *Local `json:"local,omitempty"`
 	KFP   *KFP   `json:"kfp,omitempty"`
+	RQ    *RQ    `json:"rq,omitempty"`
 }
 
 // Local configures the docling-serve engine.
@@ -100,12 +609,169 @@ type KFP struct {
 	Endpoint string `json:"endpoint"`
 }
 
+// RQ configures the Redis Queue engine. The docling-serve pods enqueue the tasks in Redis and separate worker pods
+// process them, so that every replica sees every task.
+type RQ struct {
+	// Redis is the server the tasks are queued in.
+	// +kubebuilder:validation:Required
+	Redis Redis `json:"redis"`
+
+	// Workers is the number of worker pods processing the tasks, sized independently of the API server instances.
+	// The worker pods are configured by spec.worker.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Number of RQ Workers",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:podCount"}
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:default=1
+	Workers int32 `json:"workers,omitempty"`
+}
+
+// Redis locates the Redis server of the RQ engine, external or deployed by the operator.
+// +kubebuilder:validation:XValidation:rule="(has(self.url) ? 1 : 0) + (has(self.urlSecretRef) ? 1 : 0) + (has(self.managed) ? 1 : 0) == 1", message="Exactly one of url, urlSecretRef or managed must be set"
+type Redis struct {
+	// URL of an external Redis server, e.g. redis://redis.example.svc:6379/0.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Redis URL",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	// +kubebuilder:validation:Optional
+	URL string `json:"url,omitempty"`
+
+	// URLSecretRef selects the key of a secret holding the URL of an external Redis server, when it embeds credentials.
+	// +kubebuilder:validation:Optional
+	URLSecretRef *v1.SecretKeySelector `json:"urlSecretRef,omitempty"`
+
+	// Managed deploys a Redis server for the DoclingServe, in a StatefulSet protected by a generated password.
+	// +kubebuilder:validation:Optional
+	Managed *ManagedRedis `json:"managed,omitempty"`
+}
+
+// ManagedRedis configures the Redis server deployed by the operator.
+type ManagedRedis struct {
+	// Image of the Redis server. It must read its password from the REDIS_PASSWORD variable and store its data in
+	// /var/lib/redis/data, like the Software Collections images.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Redis Image",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="quay.io/sclorg/redis-7-c9s:c9s"
+	Image string `json:"image,omitempty"`
+
+	// Resources of the Redis container.
+	// +kubebuilder:validation:Optional
+	Resources *v1.ResourceRequirements `json:"resources,omitempty"`
+
+	// StorageSize requests a persistent volume for the queued tasks, which are lost when Redis restarts otherwise.
+	// The storage is only configured when the StatefulSet is created.
+	// +kubebuilder:validation:Optional
+	StorageSize *resource.Quantity `json:"storageSize,omitempty"`
+
+	// StorageClassName is the storage class of the persistent volume. The default storage class is used when it is empty.
+	// +kubebuilder:validation:Optional
+	StorageClassName *string `json:"storageClassName,omitempty"`
+}
+
+// Models provisions a persistent cache of the docling models, filled by a download job before the docling-serve pods
+// roll out, so that they do not download the models on their first conversion.
+type Models struct {
+	// Enabled determines whether the models are preloaded in a persistent volume.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Preload Models",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:booleanSwitch"}
+	// +kubebuilder:validation:Optional
+	Enabled bool `json:"enabled,omitempty"`
+
+	// CacheName is the name of a DoclingModelCache, in the same namespace, whose volume is mounted instead of a volume
+	// dedicated to this DoclingServe. The pods roll out once the cache is Ready, the volume settings below are unused.
+	// +kubebuilder:validation:Optional
+	CacheName string `json:"cacheName,omitempty"`
+
+	// Image is an OCI image holding the models, such as a modelcar image mirrored with the other images of an
+	// air-gapped cluster. Its models are mounted in the pods instead of being downloaded, the volume settings below are
+	// unused.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Models Image",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	// +kubebuilder:validation:Optional
+	Image string `json:"image,omitempty"`
+
+	// ImagePath is the directory of the models in the image.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern=`^/`
+	// +kubebuilder:default="/models"
+	ImagePath string `json:"imagePath,omitempty"`
+
+	// ImageMountMode selects how the image reaches the pods. InitContainer runs cp in the image to copy the models into
+	// an emptyDir volume when each pod starts, so the image needs a shell userland and cannot be built from scratch.
+	// ImageVolume mounts the image as a read-only volume without copying it, it requires a cluster with the
+	// ImageVolume feature of Kubernetes 1.31 or later enabled.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Models Image Mount Mode",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:select:InitContainer","urn:alm:descriptor:com.tectonic.ui:select:ImageVolume"}
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=InitContainer;ImageVolume
+	// +kubebuilder:default=InitContainer
+	ImageMountMode string `json:"imageMountMode,omitempty"`
+
+	// StorageClassName is the storage class of the model volume. The default storage class is used when it is empty.
+	// +kubebuilder:validation:Optional
+	StorageClassName *string `json:"storageClassName,omitempty"`
+
+	// Size is the requested size of the model volume. It can be increased when the storage class allows expansion.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="10Gi"
+	Size *resource.Quantity `json:"size,omitempty"`
+
+	// AccessMode of the model volume. The pods mount it read-only. A ReadWriteOnce volume runs all the pods mounting
+	// it on a single node, ReadWriteMany lets them run on several nodes.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=ReadWriteOnce;ReadWriteMany
+	// +kubebuilder:default=ReadWriteOnce
+	AccessMode v1.PersistentVolumeAccessMode `json:"accessMode,omitempty"`
+
+	// Bundles lists the model bundles to download, as named by docling-tools models download, e.g. layout,
+	// tableformer or easyocr. The default bundles of docling-tools are downloaded when it is empty.
+	// +kubebuilder:validation:Optional
+	// +listType=set
+	Bundles []string `json:"bundles,omitempty"`
+
+	// HFTokenSecretRef selects the key of a secret holding the Hugging Face token used by the download.
+	// +kubebuilder:validation:Optional
+	HFTokenSecretRef *v1.SecretKeySelector `json:"hfTokenSecretRef,omitempty"`
+}
+
+// NetworkPolicy restricts the traffic of the docling-serve pods.
+// +kubebuilder:validation:XValidation:rule="!has(self.mode) || self.mode != 'allowFrom' || (has(self.from) && size(self.from) > 0)", message="from must list at least one peer for the allowFrom mode"
+type NetworkPolicy struct {
+	// Mode selects who may connect to docling-serve: anyone (none, no policy is created), the pods of the namespace and
+	// the ingress controllers (restrictToNamespace), or additionally the peers listed in from (allowFrom).
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Network Policy Mode",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:select:none","urn:alm:descriptor:com.tectonic.ui:select:restrictToNamespace","urn:alm:descriptor:com.tectonic.ui:select:allowFrom"}
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=none;restrictToNamespace;allowFrom
+	// +kubebuilder:default=none
+	Mode string `json:"mode,omitempty"`
+
+	// From lists the additional peers allowed to connect to docling-serve in the allowFrom mode.
+	// +kubebuilder:validation:Optional
+	From []networkingv1.NetworkPolicyPeer `json:"from,omitempty"`
+
+	// IngressControllerNamespaceSelector selects the namespaces of the ingress controller or the gateway.
+	// It defaults to the OpenShift router namespaces when the route is enabled.
+	// +kubebuilder:validation:Optional
+	IngressControllerNamespaceSelector *metav1.LabelSelector `json:"ingressControllerNamespaceSelector,omitempty"`
+
+	// RestrictEgress determines whether the connections opened by docling-serve are restricted too. DNS, the Kubeflow
+	// Pipelines endpoint and, when enabled, the model downloads remain allowed, as well as the egress rules.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Restrict Egress",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:booleanSwitch"}
+	// +kubebuilder:validation:Optional
+	RestrictEgress bool `json:"restrictEgress,omitempty"`
+
+	// AllowModelDownloads allows HTTPS connections outside the cluster, e.g. to download models from Hugging Face,
+	// while the egress is restricted.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Allow Model Downloads",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:booleanSwitch"}
+	// +kubebuilder:validation:Optional
+	AllowModelDownloads bool `json:"allowModelDownloads,omitempty"`
+
+	// Egress lists additional egress rules while the egress is restricted, e.g. to a model mirror.
+	// +kubebuilder:validation:Optional
+	Egress []networkingv1.NetworkPolicyEgressRule `json:"egress,omitempty"`
+}
+
 // DoclingServeStatus defines the observed state of DoclingServe
 type DoclingServeStatus struct {
 	// INSERT ADDITIONAL STATUS FIELD - define observed state of cluster
 	// Important: Run "make" to regenerate code after modifying this file
 
 	// Conditions describe the state of the operator's reconciliation functionality.
+	// Ready, Progressing and Degraded summarize the state of all the resources managed for the DoclingServe.
 	// +patchMergeKey=type
 	// +patchStrategy=merge
 	// +optional
@@ -115,10 +781,50 @@ type DoclingServeStatus struct {
 	// ObservedGeneration is the generation last observed by the controller
 	// +optional
 	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Replicas is the number of docling-serve pods targeted by the workload.
+	// +optional
+	Replicas int32 `json:"replicas,omitempty"`
+
+	// Selector is the label selector of the docling-serve pods, used by the scale subresource.
+	// +optional
+	Selector string `json:"selector,omitempty"`
+
+	// ReadyReplicas is the number of docling-serve pods ready to serve requests.
+	// +operator-sdk:csv:customresourcedefinitions:type=status,displayName="Ready Replicas",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:podCount"}
+	// +optional
+	ReadyReplicas int32 `json:"readyReplicas,omitempty"`
+
+	// URL is the address the Docling API is exposed at outside the cluster, through the route or the ingress.
+	// +operator-sdk:csv:customresourcedefinitions:type=status,displayName="URL",xDescriptors={"urn:alm:descriptor:org.w3:link"}
+	// +optional
+	URL string `json:"url,omitempty"`
+
+	// APIKeySecretName is the name of the secret holding the API key clients authenticate with.
+	// +operator-sdk:csv:customresourcedefinitions:type=status,displayName="API Key Secret",xDescriptors={"urn:alm:descriptor:io.kubernetes:Secret"}
+	// +optional
+	APIKeySecretName string `json:"apiKeySecretName,omitempty"`
+
+	// WorkerReplicas is the number of RQ worker pods targeted by the worker deployment.
+	// +optional
+	WorkerReplicas int32 `json:"workerReplicas,omitempty"`
+
+	// WorkerReadyReplicas is the number of RQ worker pods ready to run conversions.
+	// +operator-sdk:csv:customresourcedefinitions:type=status,displayName="Ready Workers",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:podCount"}
+	// +optional
+	WorkerReadyReplicas int32 `json:"workerReadyReplicas,omitempty"`
 }
 
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
+// +kubebuilder:subresource:scale:specpath=.spec.apiServer.instances,statuspath=.status.replicas,selectorpath=.status.selector
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].status"
+// +kubebuilder:printcolumn:name="Replicas",type="integer",JSONPath=".status.replicas"
+// +kubebuilder:printcolumn:name="Available",type="integer",JSONPath=".status.readyReplicas"
+// +kubebuilder:printcolumn:name="Workers",type="integer",JSONPath=".status.workerReadyReplicas",priority=1
+// +kubebuilder:printcolumn:name="URL",type="string",JSONPath=".status.url"
+// +kubebuilder:printcolumn:name="Reason",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].reason",priority=1
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 
 // DoclingServe is the Schema for the doclingserves API
 type DoclingServe struct {
@@ -0,0 +1,175 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.io/docling-project/docling-operator/api/v1beta1"
+)
+
+const (
+	// DefaultImage is the docling-serve image deployed when none is specified.
+	DefaultImage = v1beta1.DefaultImage
+	// DefaultNumWorkers is the number of local engine workers used when none is specified.
+	DefaultNumWorkers = v1beta1.DefaultNumWorkers
+	// DefaultOAuthProxyImage is the OAuth proxy image injected when none is specified.
+	DefaultOAuthProxyImage = v1beta1.DefaultOAuthProxyImage
+)
+
+// log is for logging in this package.
+var doclingservelog = logf.Log.WithName("doclingserve-resource")
+
+// SetupWebhookWithManager will setup the manager to manage the webhooks
+func (r *DoclingServe) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).For(r).
+		WithDefaulter(&DoclingServeCustomDefaulter{}).
+		WithValidator(&DoclingServeCustomValidator{}).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/mutate-docling-github-io-v1alpha1-doclingserve,mutating=true,failurePolicy=fail,sideEffects=None,groups=docling.github.io,resources=doclingserves,verbs=create;update,versions=v1alpha1,name=mdoclingserve-v1alpha1.kb.io,admissionReviewVersions=v1
+
+// DoclingServeCustomDefaulter sets default values on the DoclingServe resource when it is created or updated.
+// +kubebuilder:object:generate=false
+type DoclingServeCustomDefaulter struct{}
+
+var _ webhook.CustomDefaulter = &DoclingServeCustomDefaulter{}
+
+// Default implements webhook.CustomDefaulter so a webhook will be registered for the Kind DoclingServe.
+func (d *DoclingServeCustomDefaulter) Default(_ context.Context, obj runtime.Object) error {
+	doclingServe, ok := obj.(*DoclingServe)
+	if !ok {
+		return fmt.Errorf("expected a DoclingServe object but got %T", obj)
+	}
+	doclingservelog.Info("Defaulting for DoclingServe", "name", doclingServe.GetName())
+
+	spec := &doclingServe.Spec
+	if spec.APIServer == nil {
+		spec.APIServer = &APIServer{Instances: 1}
+	}
+	if spec.APIServer.Image == "" {
+		spec.APIServer.Image = DefaultImage
+	}
+	if spec.APIServer.OAuthProxy != nil && spec.APIServer.OAuthProxy.Image == "" {
+		spec.APIServer.OAuthProxy.Image = DefaultOAuthProxyImage
+	}
+
+	if spec.Engine == nil {
+		spec.Engine = &Engine{}
+	}
+	if spec.Engine.Local == nil && spec.Engine.KFP == nil && spec.Engine.RQ == nil {
+		spec.Engine.Local = &Local{}
+	}
+	if spec.Engine.Local != nil && spec.Engine.Local.NumWorkers == 0 {
+		spec.Engine.Local.NumWorkers = DefaultNumWorkers
+	}
+
+	return nil
+}
+
+// +kubebuilder:webhook:path=/validate-docling-github-io-v1alpha1-doclingserve,mutating=false,failurePolicy=fail,sideEffects=None,groups=docling.github.io,resources=doclingserves,verbs=create;update,versions=v1alpha1,name=vdoclingserve-v1alpha1.kb.io,admissionReviewVersions=v1
+
+// DoclingServeCustomValidator validates the DoclingServe resource when it is created or updated.
+// +kubebuilder:object:generate=false
+type DoclingServeCustomValidator struct{}
+
+var _ webhook.CustomValidator = &DoclingServeCustomValidator{}
+
+// ValidateCreate implements webhook.CustomValidator so a webhook will be registered for the type DoclingServe.
+func (v *DoclingServeCustomValidator) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	doclingServe, ok := obj.(*DoclingServe)
+	if !ok {
+		return nil, fmt.Errorf("expected a DoclingServe object but got %T", obj)
+	}
+
+	hub := &v1beta1.DoclingServe{}
+	if err := doclingServe.ConvertTo(hub); err != nil {
+		return nil, err
+	}
+	return fromHubValidation((&v1beta1.DoclingServeCustomValidator{}).ValidateCreate(ctx, hub))
+}
+
+// ValidateUpdate implements webhook.CustomValidator so a webhook will be registered for the type DoclingServe.
+func (v *DoclingServeCustomValidator) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	doclingServe, ok := newObj.(*DoclingServe)
+	if !ok {
+		return nil, fmt.Errorf("expected a DoclingServe object for the newObj but got %T", newObj)
+	}
+	old, ok := oldObj.(*DoclingServe)
+	if !ok {
+		return nil, fmt.Errorf("expected a DoclingServe object for the oldObj but got %T", oldObj)
+	}
+
+	hub, oldHub := &v1beta1.DoclingServe{}, &v1beta1.DoclingServe{}
+	if err := doclingServe.ConvertTo(hub); err != nil {
+		return nil, err
+	}
+	if err := old.ConvertTo(oldHub); err != nil {
+		return nil, err
+	}
+	return fromHubValidation((&v1beta1.DoclingServeCustomValidator{}).ValidateUpdate(ctx, oldHub, hub))
+}
+
+// ValidateDelete implements webhook.CustomValidator so a webhook will be registered for the type DoclingServe.
+func (v *DoclingServeCustomValidator) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// hubPaths rewrites the field paths of the hub version into their v1alpha1 location.
+var hubPaths = strings.NewReplacer(
+	"spec.workload.replicas", "spec.apiServer.instances",
+	"spec.workload", "spec.apiServer",
+	"spec.exposure.route", "spec.route",
+	"spec.exposure.ingress", "spec.ingress",
+	"spec.exposure.gateway", "spec.gateway",
+)
+
+// fromHubValidation reports the warnings and the errors of the hub validation with the v1alpha1 field paths. The
+// DoclingServe is validated once, by the rules of the hub version it is converted to.
+func fromHubValidation(warnings admission.Warnings, err error) (admission.Warnings, error) {
+	for i := range warnings {
+		warnings[i] = hubPaths.Replace(warnings[i])
+	}
+
+	statusErr, ok := err.(*apierrors.StatusError)
+	if !ok {
+		return warnings, err
+	}
+	status := statusErr.Status()
+	status.Message = hubPaths.Replace(status.Message)
+	if status.Details != nil {
+		details := *status.Details
+		details.Causes = slices.Clone(details.Causes)
+		for i := range details.Causes {
+			details.Causes[i].Field = hubPaths.Replace(details.Causes[i].Field)
+			details.Causes[i].Message = hubPaths.Replace(details.Causes[i].Message)
+		}
+		status.Details = &details
+	}
+	return warnings, &apierrors.StatusError{ErrStatus: status}
+}
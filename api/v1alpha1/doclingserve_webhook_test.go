@@ -0,0 +1,286 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+var _ = Describe("DoclingServe Webhook", func() {
+	var (
+		obj       *DoclingServe
+		defaulter DoclingServeCustomDefaulter
+		validator DoclingServeCustomValidator
+	)
+
+	BeforeEach(func() {
+		obj = &DoclingServe{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-resource", Namespace: "default"},
+			Spec: DoclingServeSpec{
+				APIServer: &APIServer{
+					Image:     "quay.io/docling-project/docling-serve:v1.0.0",
+					Instances: 1,
+				},
+				Engine: &Engine{Local: &Local{NumWorkers: 2}},
+			},
+		}
+	})
+
+	Context("When creating DoclingServe under Defaulting Webhook", func() {
+		It("Should fill in the image and the local engine", func() {
+			obj.Spec = DoclingServeSpec{APIServer: &APIServer{Instances: 1}}
+
+			Expect(defaulter.Default(context.Background(), obj)).To(Succeed())
+			Expect(obj.Spec.APIServer.Image).To(Equal(DefaultImage))
+			Expect(obj.Spec.Engine.Local).NotTo(BeNil())
+			Expect(obj.Spec.Engine.Local.NumWorkers).To(Equal(int32(DefaultNumWorkers)))
+		})
+
+		It("Should not add a local engine when KFP is configured", func() {
+			obj.Spec.Engine = &Engine{KFP: &KFP{Endpoint: "https://kfp.example.com:8888"}}
+
+			Expect(defaulter.Default(context.Background(), obj)).To(Succeed())
+			Expect(obj.Spec.Engine.Local).To(BeNil())
+		})
+	})
+
+	Context("When creating or updating DoclingServe under Validating Webhook", func() {
+		It("Should admit a valid resource without warnings", func() {
+			warnings, err := validator.ValidateCreate(context.Background(), obj)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(BeEmpty())
+		})
+
+		It("Should deny a name that makes the derived resource names too long", func() {
+			obj.Name = strings.Repeat("a", 60)
+
+			_, err := validator.ValidateCreate(context.Background(), obj)
+			Expect(apierrors.IsInvalid(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring(obj.Name + "-deployment"))
+		})
+
+		It("Should not check the derived names of an existing name", func() {
+			obj.Name = strings.Repeat("a", 60)
+
+			_, err := validator.ValidateUpdate(context.Background(), obj.DeepCopy(), obj)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("Should deny an invalid KFP endpoint", func() {
+			obj.Spec.Engine = &Engine{KFP: &KFP{Endpoint: "kfp.example.com"}}
+
+			_, err := validator.ValidateUpdate(context.Background(), obj, obj)
+			Expect(apierrors.IsInvalid(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("spec.engine.kfp.endpoint"))
+		})
+
+		It("Should deny a Redis URL of another scheme", func() {
+			obj.Spec.Engine = &Engine{RQ: &RQ{Workers: 1, Redis: Redis{URL: "https://redis.example.com:6379"}}}
+
+			_, err := validator.ValidateCreate(context.Background(), obj)
+			Expect(apierrors.IsInvalid(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("spec.engine.rq.redis.url"))
+		})
+
+		It("Should deny worker pods without the RQ engine", func() {
+			obj.Spec.Worker = &Worker{PriorityClassName: "docling-workers"}
+
+			_, err := validator.ValidateCreate(context.Background(), obj)
+			Expect(apierrors.IsInvalid(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("spec.worker"))
+		})
+
+		It("Should deny an invalid service account name", func() {
+			obj.Spec.APIServer.ServiceAccountName = "Docling_SA"
+
+			_, err := validator.ValidateCreate(context.Background(), obj)
+			Expect(apierrors.IsInvalid(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("spec.apiServer.serviceAccountName"))
+		})
+
+		It("Should deny overriding the variables managed by the operator", func() {
+			obj.Spec.APIServer.Env = []corev1.EnvVar{
+				{Name: "DOCLING_SERVE_MAX_NUM_PAGES", Value: "100"},
+				{Name: "DOCLING_SERVE_ENG_KIND", Value: "kfp"},
+			}
+
+			_, err := validator.ValidateCreate(context.Background(), obj)
+			Expect(apierrors.IsInvalid(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("spec.apiServer.env[1].name"))
+			Expect(err.Error()).NotTo(ContainSubstring("spec.apiServer.env[0].name"))
+		})
+
+		It("Should deny settings the image does not read", func() {
+			obj.Spec.APIServer.Image = "quay.io/docling-project/docling-serve:v0.6.0"
+			obj.Spec.APIServer.Settings = &Settings{MaxNumPages: ptr.To(int32(50)), AllowedOCREngines: []string{"easyocr"}}
+
+			_, err := validator.ValidateCreate(context.Background(), obj)
+			Expect(apierrors.IsInvalid(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("spec.apiServer.settings.allowedOCREngines"))
+			Expect(err.Error()).NotTo(ContainSubstring("spec.apiServer.settings.maxNumPages"))
+		})
+
+		It("Should deny overriding a setting with an environment variable", func() {
+			obj.Spec.APIServer.Settings = &Settings{MaxNumPages: ptr.To(int32(50))}
+			obj.Spec.APIServer.Env = []corev1.EnvVar{{Name: "DOCLING_SERVE_MAX_NUM_PAGES", Value: "100"}}
+
+			_, err := validator.ValidateCreate(context.Background(), obj)
+			Expect(apierrors.IsInvalid(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("spec.apiServer.env[0].name"))
+		})
+
+		It("Should deny an invalid priority class name", func() {
+			obj.Spec.APIServer.PriorityClassName = "Docling_Priority"
+
+			_, err := validator.ValidateCreate(context.Background(), obj)
+			Expect(apierrors.IsInvalid(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("spec.apiServer.priorityClassName"))
+		})
+
+		It("Should deny an artifacts path together with the preloaded models", func() {
+			obj.Spec.Models = &Models{Enabled: true}
+			obj.Spec.APIServer.Settings = &Settings{ArtifactsPath: "/opt/models"}
+
+			_, err := validator.ValidateCreate(context.Background(), obj)
+			Expect(apierrors.IsInvalid(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("spec.apiServer.settings.artifactsPath"))
+		})
+
+		It("Should deny download settings together with a shared model cache", func() {
+			obj.Spec.Models = &Models{Enabled: true, CacheName: "shared", Bundles: []string{"layout"}}
+
+			_, err := validator.ValidateCreate(context.Background(), obj)
+			Expect(apierrors.IsInvalid(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("spec.models.bundles"))
+		})
+
+		It("Should warn about a models image copied by the init container", func() {
+			obj.Spec.Models = &Models{Enabled: true, Image: "registry.example.com/docling-models:v1"}
+
+			warnings, err := validator.ValidateCreate(context.Background(), obj)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf(HavePrefix("spec.models.image")))
+		})
+
+		It("Should deny a models image together with a shared model cache", func() {
+			obj.Spec.Models = &Models{Enabled: true, CacheName: "shared", Image: "registry.example.com/docling-models:v1"}
+
+			_, err := validator.ValidateCreate(context.Background(), obj)
+			Expect(apierrors.IsInvalid(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("spec.models.image"))
+		})
+
+		It("Should deny a negative API key rotation interval", func() {
+			obj.Spec.APIServer.Authentication = &Authentication{Enabled: true, RotationInterval: &metav1.Duration{Duration: -time.Hour}}
+
+			_, err := validator.ValidateCreate(context.Background(), obj)
+			Expect(apierrors.IsInvalid(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("spec.apiServer.authentication.rotationInterval"))
+		})
+
+		It("Should deny the OAuth proxy behind an ingress", func() {
+			obj.Spec.APIServer.OAuthProxy = &OAuthProxy{Enabled: true}
+			obj.Spec.Ingress = &Ingress{Enabled: true}
+
+			_, err := validator.ValidateCreate(context.Background(), obj)
+			Expect(apierrors.IsInvalid(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("spec.apiServer.oauthProxy.enabled"))
+		})
+
+		It("Should deny TLS together with the OAuth proxy", func() {
+			obj.Spec.APIServer.OAuthProxy = &OAuthProxy{Enabled: true}
+			obj.Spec.APIServer.TLS = &TLS{Enabled: true}
+
+			_, err := validator.ValidateCreate(context.Background(), obj)
+			Expect(apierrors.IsInvalid(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("spec.apiServer.tls.enabled"))
+		})
+
+		It("Should deny the OAuth proxy with a user service account", func() {
+			obj.Spec.APIServer.OAuthProxy = &OAuthProxy{Enabled: true}
+			obj.Spec.APIServer.ServiceAccountName = "docling"
+
+			_, err := validator.ValidateCreate(context.Background(), obj)
+			Expect(apierrors.IsInvalid(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("spec.apiServer.serviceAccountName"))
+		})
+
+		It("Should deny a route timeout together with the timeout annotation", func() {
+			obj.Spec.Route = &Route{
+				Enabled:     true,
+				Timeout:     "10m",
+				Annotations: map[string]string{"haproxy.router.openshift.io/timeout": "1h"},
+			}
+
+			_, err := validator.ValidateCreate(context.Background(), obj)
+			Expect(apierrors.IsInvalid(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("spec.route.annotations[haproxy.router.openshift.io/timeout]"))
+		})
+
+		It("Should warn about the latest tag of the managed Redis", func() {
+			obj.Spec.Engine = &Engine{RQ: &RQ{Workers: 1, Redis: Redis{Managed: &ManagedRedis{Image: "quay.io/sclorg/redis-7-c9s:latest"}}}}
+
+			warnings, err := validator.ValidateCreate(context.Background(), obj)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf(HavePrefix("spec.engine.rq.redis.managed.image")))
+		})
+
+		It("Should warn when the network policy does not select the ingress controller", func() {
+			obj.Spec.NetworkPolicy = &NetworkPolicy{Mode: "restrictToNamespace"}
+			obj.Spec.Ingress = &Ingress{Enabled: true}
+
+			warnings, err := validator.ValidateCreate(context.Background(), obj)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf(ContainSubstring("spec.networkPolicy.ingressControllerNamespaceSelector")))
+		})
+
+		It("Should warn about the latest tag and zero instances", func() {
+			obj.Spec.APIServer.Image = "quay.io/docling-project/docling-serve"
+			obj.Spec.APIServer.Instances = 0
+
+			warnings, err := validator.ValidateCreate(context.Background(), obj)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(HaveLen(2))
+			Expect(warnings[1]).To(HavePrefix("spec.apiServer.instances"))
+		})
+
+		It("Should warn when the autoscaler targets a resource that is not requested", func() {
+			obj.Spec.APIServer.Autoscaling = &Autoscaling{Enabled: true, MaxReplicas: 3}
+
+			warnings, err := validator.ValidateCreate(context.Background(), obj)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf(ContainSubstring("no CPU request")))
+		})
+
+		It("Should not treat a registry port as an image tag", func() {
+			obj.Spec.APIServer.Image = "registry.example.com:5000/docling-serve:v1.0.0"
+
+			warnings, err := validator.ValidateCreate(context.Background(), obj)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(BeEmpty())
+		})
+	})
+})
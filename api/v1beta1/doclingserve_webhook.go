@@ -0,0 +1,375 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"context"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.io/docling-project/docling-operator/api/internal/validate"
+)
+
+const (
+	// DefaultImage is the docling-serve image deployed when none is specified, pinned so that every pod runs the same
+	// release.
+	DefaultImage = "quay.io/docling-project/docling-serve:v1.0.0"
+	// DefaultNumWorkers is the number of local engine workers used when none is specified.
+	DefaultNumWorkers = 2
+	// routeTimeoutAnnotation configures the timeout of the OpenShift router, it is set from the route timeout.
+	routeTimeoutAnnotation = "haproxy.router.openshift.io/timeout"
+	// DefaultOAuthProxyImage is the OAuth proxy image injected when none is specified.
+	DefaultOAuthProxyImage = "quay.io/openshift/origin-oauth-proxy:4.17"
+)
+
+// log is for logging in this package.
+var doclingservelog = logf.Log.WithName("doclingserve-resource")
+
+// SetupWebhookWithManager will setup the manager to manage the webhooks.
+// Registering the hub also serves the conversion webhook for all the DoclingServe versions.
+func (r *DoclingServe) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).For(r).
+		WithDefaulter(&DoclingServeCustomDefaulter{}).
+		WithValidator(&DoclingServeCustomValidator{}).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/mutate-docling-github-io-v1beta1-doclingserve,mutating=true,failurePolicy=fail,sideEffects=None,groups=docling.github.io,resources=doclingserves,verbs=create;update,versions=v1beta1,name=mdoclingserve-v1beta1.kb.io,admissionReviewVersions=v1
+
+// DoclingServeCustomDefaulter sets default values on the DoclingServe resource when it is created or updated.
+// +kubebuilder:object:generate=false
+type DoclingServeCustomDefaulter struct{}
+
+var _ webhook.CustomDefaulter = &DoclingServeCustomDefaulter{}
+
+// Default implements webhook.CustomDefaulter so a webhook will be registered for the Kind DoclingServe.
+func (d *DoclingServeCustomDefaulter) Default(_ context.Context, obj runtime.Object) error {
+	doclingServe, ok := obj.(*DoclingServe)
+	if !ok {
+		return fmt.Errorf("expected a DoclingServe object but got %T", obj)
+	}
+	doclingservelog.Info("Defaulting for DoclingServe", "name", doclingServe.GetName())
+
+	spec := &doclingServe.Spec
+	if spec.Workload == nil {
+		spec.Workload = &Workload{Replicas: 1}
+	}
+	if spec.Workload.Image == "" {
+		spec.Workload.Image = DefaultImage
+	}
+	if spec.Workload.OAuthProxy != nil && spec.Workload.OAuthProxy.Image == "" {
+		spec.Workload.OAuthProxy.Image = DefaultOAuthProxyImage
+	}
+
+	if spec.Engine == nil {
+		spec.Engine = &Engine{}
+	}
+	if spec.Engine.Type == "" {
+		spec.Engine.Type = EngineTypeLocal
+	}
+	if spec.Engine.Type == EngineTypeLocal {
+		if spec.Engine.Local == nil {
+			spec.Engine.Local = &LocalEngine{}
+		}
+		if spec.Engine.Local.NumWorkers == 0 {
+			spec.Engine.Local.NumWorkers = DefaultNumWorkers
+		}
+	}
+
+	return nil
+}
+
+// +kubebuilder:webhook:path=/validate-docling-github-io-v1beta1-doclingserve,mutating=false,failurePolicy=fail,sideEffects=None,groups=docling.github.io,resources=doclingserves,verbs=create;update,versions=v1beta1,name=vdoclingserve-v1beta1.kb.io,admissionReviewVersions=v1
+
+// DoclingServeCustomValidator validates the DoclingServe resource when it is created or updated.
+// +kubebuilder:object:generate=false
+type DoclingServeCustomValidator struct{}
+
+var _ webhook.CustomValidator = &DoclingServeCustomValidator{}
+
+// ValidateCreate implements webhook.CustomValidator so a webhook will be registered for the type DoclingServe.
+func (v *DoclingServeCustomValidator) ValidateCreate(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	doclingServe, ok := obj.(*DoclingServe)
+	if !ok {
+		return nil, fmt.Errorf("expected a DoclingServe object but got %T", obj)
+	}
+	doclingservelog.Info("Validation for DoclingServe upon creation", "name", doclingServe.GetName())
+
+	return doclingServe.validate(nil)
+}
+
+// ValidateUpdate implements webhook.CustomValidator so a webhook will be registered for the type DoclingServe.
+func (v *DoclingServeCustomValidator) ValidateUpdate(_ context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	doclingServe, ok := newObj.(*DoclingServe)
+	if !ok {
+		return nil, fmt.Errorf("expected a DoclingServe object for the newObj but got %T", newObj)
+	}
+	old, ok := oldObj.(*DoclingServe)
+	if !ok {
+		return nil, fmt.Errorf("expected a DoclingServe object for the oldObj but got %T", oldObj)
+	}
+	doclingservelog.Info("Validation for DoclingServe upon update", "name", doclingServe.GetName())
+
+	return doclingServe.validate(old)
+}
+
+// ValidateDelete implements webhook.CustomValidator so a webhook will be registered for the type DoclingServe.
+func (v *DoclingServeCustomValidator) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// validate returns the warnings and the aggregated validation errors of the DoclingServe, updated from old unless it
+// is created.
+func (r *DoclingServe) validate(old *DoclingServe) (admission.Warnings, error) {
+	var warnings admission.Warnings
+	var allErrs field.ErrorList
+	// An existing resource keeps the name it was admitted with: a feature enabled later whose derived name is too long
+	// fails to reconcile instead of blocking every update.
+	if old == nil || old.Name != r.Name {
+		allErrs = append(allErrs, validate.DerivedNames(r.Name, r.derivedNameSuffixes())...)
+	}
+
+	specPath := field.NewPath("spec")
+	if r.Spec.Workload == nil {
+		allErrs = append(allErrs, field.Required(specPath.Child("workload"), "the docling-serve workload must be configured"))
+	} else {
+		workloadPath := specPath.Child("workload")
+		if validate.UsesLatestTag(r.Spec.Workload.Image) {
+			warnings = append(warnings, fmt.Sprintf("%s: image %q uses the latest tag, pods may run different docling-serve versions; pin a version or digest instead",
+				workloadPath.Child("image"), r.Spec.Workload.Image))
+		}
+		if r.Spec.Workload.Replicas < 0 {
+			allErrs = append(allErrs, field.Invalid(workloadPath.Child("replicas"), r.Spec.Workload.Replicas, "must be greater than or equal to 0"))
+		} else if r.Spec.Workload.Replicas == 0 {
+			warnings = append(warnings, fmt.Sprintf("%s: no docling-serve instances will be running", workloadPath.Child("replicas")))
+		}
+		allErrs = append(allErrs, validate.EnvVars(workloadPath.Child("env"), r.Spec.Workload.Env)...)
+		if r.Spec.Workload.Settings != nil {
+			allErrs = append(allErrs, validate.Settings(workloadPath.Child("settings"), r.Spec.Workload.Image, r.Spec.Workload.Settings,
+				workloadPath.Child("env"), r.Spec.Workload.Env)...)
+		}
+		if r.Spec.Workload.PriorityClassName != "" {
+			allErrs = append(allErrs, validate.ObjectName(workloadPath.Child("priorityClassName"), r.Spec.Workload.PriorityClassName)...)
+		}
+		if r.Spec.Workload.RuntimeClassName != nil {
+			allErrs = append(allErrs, validate.ObjectName(workloadPath.Child("runtimeClassName"), *r.Spec.Workload.RuntimeClassName)...)
+		}
+		if r.Spec.Workload.ServiceAccountName != "" {
+			allErrs = append(allErrs, validate.ObjectName(workloadPath.Child("serviceAccountName"), r.Spec.Workload.ServiceAccountName)...)
+		}
+		if authentication := r.Spec.Workload.Authentication; authentication != nil {
+			authenticationPath := workloadPath.Child("authentication")
+			if authentication.SecretName != "" {
+				allErrs = append(allErrs, validate.ObjectName(authenticationPath.Child("secretName"), authentication.SecretName)...)
+			}
+			if authentication.RotationInterval != nil && authentication.RotationInterval.Duration <= 0 {
+				allErrs = append(allErrs, field.Invalid(authenticationPath.Child("rotationInterval"), authentication.RotationInterval.Duration.String(), "must be greater than 0"))
+			}
+		}
+		if autoscaling := r.Spec.Workload.Autoscaling; autoscaling != nil && autoscaling.Enabled && autoscaling.Mode != "KEDA" {
+			warnings = append(warnings, validate.AutoscalingRequests(workloadPath, r.Spec.Workload.Resources,
+				autoscaling.TargetCPUUtilizationPercentage, autoscaling.TargetMemoryUtilizationPercentage)...)
+		}
+	}
+
+	if models := r.Spec.Models; models != nil && models.Enabled {
+		modelsPath := specPath.Child("models")
+		if models.HFTokenSecretRef != nil {
+			allErrs = append(allErrs, validate.ObjectName(modelsPath.Child("hfTokenSecretRef", "name"), models.HFTokenSecretRef.Name)...)
+		}
+		if models.CacheName != "" {
+			allErrs = append(allErrs, validate.ObjectName(modelsPath.Child("cacheName"), models.CacheName)...)
+			if models.Image != "" {
+				allErrs = append(allErrs, field.Forbidden(modelsPath.Child("image"), "mount the models from either cacheName or image"))
+			}
+		}
+		if models.Image != "" && validate.UsesLatestTag(models.Image) {
+			warnings = append(warnings, fmt.Sprintf("%s: image %q uses the latest tag, pods may load different models; pin a version or digest instead",
+				modelsPath.Child("image"), models.Image))
+		}
+		if models.Image != "" && models.ImageMountMode != "ImageVolume" {
+			warnings = append(warnings, fmt.Sprintf("%s: the models are copied by running cp in image %q, which fails when the image has no shell userland; set imageMountMode to ImageVolume for an image built from scratch",
+				modelsPath.Child("image"), models.Image))
+		}
+		// The models of a shared cache or of an image are not downloaded in a dedicated volume.
+		if models.CacheName != "" || models.Image != "" {
+			source := "the DoclingModelCache referenced by cacheName"
+			if models.CacheName == "" {
+				source = "image"
+			}
+			if len(models.Bundles) > 0 {
+				allErrs = append(allErrs, field.Forbidden(modelsPath.Child("bundles"), "the models are mounted from "+source))
+			}
+			if models.HFTokenSecretRef != nil {
+				allErrs = append(allErrs, field.Forbidden(modelsPath.Child("hfTokenSecretRef"), "the models are mounted from "+source))
+			}
+			if models.StorageClassName != nil {
+				allErrs = append(allErrs, field.Forbidden(modelsPath.Child("storageClassName"), "the models are mounted from "+source))
+			}
+		}
+		if r.Spec.Workload != nil && r.Spec.Workload.Settings != nil && r.Spec.Workload.Settings.ArtifactsPath != "" {
+			allErrs = append(allErrs, field.Forbidden(specPath.Child("workload", "settings", "artifactsPath"),
+				"docling-serve loads the preloaded models, disable the models or unset artifactsPath"))
+		}
+		if r.Spec.Workload != nil {
+			for i, env := range r.Spec.Workload.Env {
+				if env.Name == "DOCLING_SERVE_ARTIFACTS_PATH" {
+					allErrs = append(allErrs, field.Forbidden(specPath.Child("workload", "env").Index(i).Child("name"),
+						"docling-serve loads the preloaded models, disable the models or remove the variable"))
+				}
+			}
+		}
+	}
+
+	if r.Spec.Engine != nil && r.Spec.Engine.Type == EngineTypeKFP && r.Spec.Engine.KFP != nil {
+		endpointPath := specPath.Child("engine", "kfp", "endpoint")
+		if err := validate.Endpoint(r.Spec.Engine.KFP.Endpoint); err != nil {
+			allErrs = append(allErrs, field.Invalid(endpointPath, r.Spec.Engine.KFP.Endpoint, err.Error()))
+		}
+	}
+
+	if r.Spec.Engine != nil && r.Spec.Engine.Type == EngineTypeRQ && r.Spec.Engine.RQ != nil {
+		redisPath := specPath.Child("engine", "rq", "redis")
+		redis := r.Spec.Engine.RQ.Redis
+		if redis.URL != "" {
+			if err := validate.RedisURL(redis.URL); err != nil {
+				allErrs = append(allErrs, field.Invalid(redisPath.Child("url"), redis.URL, err.Error()))
+			}
+		}
+		if redis.URLSecretRef != nil {
+			allErrs = append(allErrs, validate.ObjectName(redisPath.Child("urlSecretRef", "name"), redis.URLSecretRef.Name)...)
+		}
+		if redis.Managed != nil && redis.Managed.Image != "" && validate.UsesLatestTag(redis.Managed.Image) {
+			warnings = append(warnings, fmt.Sprintf("%s: image %q uses the latest tag, the Redis server may be upgraded when its pod restarts; pin a version or digest instead",
+				redisPath.Child("managed", "image"), redis.Managed.Image))
+		}
+	}
+
+	if worker := r.Spec.Worker; worker != nil {
+		workerPath := specPath.Child("worker")
+		if r.Spec.Engine == nil || r.Spec.Engine.Type != EngineTypeRQ {
+			allErrs = append(allErrs, field.Forbidden(workerPath, "only the RQ engine runs separate worker pods, configure the rq engine or remove worker"))
+		}
+		if worker.PriorityClassName != "" {
+			allErrs = append(allErrs, validate.ObjectName(workerPath.Child("priorityClassName"), worker.PriorityClassName)...)
+		}
+	}
+
+	if r.Spec.Workload != nil && r.Spec.Workload.TLS != nil && r.Spec.Workload.TLS.Enabled && r.Spec.Exposure != nil &&
+		(r.Spec.Exposure.Ingress != nil && r.Spec.Exposure.Ingress.Enabled || r.Spec.Exposure.Gateway != nil && r.Spec.Exposure.Gateway.Enabled) {
+		warnings = append(warnings, fmt.Sprintf("%s: docling-serve only serves HTTPS, configure the ingress controller or the gateway to connect to it over TLS",
+			specPath.Child("workload", "tls", "enabled")))
+	}
+	if r.Spec.Workload != nil && r.Spec.Workload.TLS != nil && r.Spec.Workload.TLS.Enabled && r.Spec.Workload.OAuthProxy != nil && r.Spec.Workload.OAuthProxy.Enabled {
+		allErrs = append(allErrs, field.Forbidden(specPath.Child("workload", "tls", "enabled"),
+			"the OAuth proxy terminates TLS itself, disable tls or the OAuth proxy"))
+	}
+
+	if r.Spec.Workload != nil && r.Spec.Workload.OAuthProxy != nil && r.Spec.Workload.OAuthProxy.Enabled && r.Spec.Exposure != nil {
+		oauthProxyPath := specPath.Child("workload", "oauthProxy", "enabled")
+		if r.Spec.Exposure.Ingress != nil && r.Spec.Exposure.Ingress.Enabled {
+			allErrs = append(allErrs, field.Forbidden(oauthProxyPath, "the OAuth proxy can only be exposed through a route, disable the ingress"))
+		}
+		if r.Spec.Exposure.Gateway != nil && r.Spec.Exposure.Gateway.Enabled {
+			allErrs = append(allErrs, field.Forbidden(oauthProxyPath, "the OAuth proxy can only be exposed through a route, disable the gateway"))
+		}
+	}
+	// The OAuth proxy authenticates as the service account of the pods, which needs the OAuth redirect reference
+	// annotation the operator only sets on the account it creates.
+	if r.Spec.Workload != nil && r.Spec.Workload.OAuthProxy != nil && r.Spec.Workload.OAuthProxy.Enabled && r.Spec.Workload.ServiceAccountName != "" {
+		allErrs = append(allErrs, field.Forbidden(specPath.Child("workload", "serviceAccountName"),
+			"the OAuth proxy runs with the service account created by the operator, remove serviceAccountName or disable the OAuth proxy"))
+	}
+
+	if r.Spec.Exposure != nil && r.Spec.Exposure.Route != nil && r.Spec.Exposure.Route.Timeout != "" {
+		if _, ok := r.Spec.Exposure.Route.Annotations[routeTimeoutAnnotation]; ok {
+			allErrs = append(allErrs, field.Forbidden(specPath.Child("exposure", "route", "annotations").Key(routeTimeoutAnnotation),
+				"the router timeout is set by timeout, remove either of them"))
+		}
+	}
+
+	if r.Spec.Exposure != nil && r.Spec.Exposure.Route != nil && r.Spec.Exposure.Route.Enabled &&
+		r.Spec.Exposure.Route.InsecureEdgeTerminationPolicy == "Allow" {
+		warnings = append(warnings, fmt.Sprintf("%s: the route serves docling-serve over plain HTTP",
+			specPath.Child("exposure", "route", "insecureEdgeTerminationPolicy")))
+	}
+
+	if networkPolicy := r.Spec.NetworkPolicy; networkPolicy != nil && networkPolicy.Mode != "" && networkPolicy.Mode != "none" &&
+		networkPolicy.IngressControllerNamespaceSelector == nil && r.Spec.Exposure != nil && (r.Spec.Exposure.Ingress != nil && r.Spec.Exposure.Ingress.Enabled || r.Spec.Exposure.Gateway != nil && r.Spec.Exposure.Gateway.Enabled) {
+		warnings = append(warnings, fmt.Sprintf("%s: the network policy only admits the ingress controller or the gateway from the namespaces it selects",
+			specPath.Child("networkPolicy", "ingressControllerNamespaceSelector")))
+	}
+
+	if len(allErrs) == 0 {
+		return warnings, nil
+	}
+	return warnings, apierrors.NewInvalid(GroupVersion.WithKind("DoclingServe").GroupKind(), r.Name, allErrs)
+}
+
+// derivedNameSuffixes lists the suffixes appended to the DoclingServe name to build the names of the resources managed
+// for the features it enables. It follows the reconcilers in internal/reconcilers, which cannot be imported here.
+func (r *DoclingServe) derivedNameSuffixes() []string {
+	suffixes := []string{"-deployment", "-service"}
+	if workload := r.Spec.Workload; workload != nil {
+		if workload.ServiceAccountName == "" {
+			suffixes = append(suffixes, "-sa")
+		}
+		if workload.Authentication != nil && workload.Authentication.Enabled {
+			suffixes = append(suffixes, "-api-key")
+		}
+		if workload.Autoscaling != nil && workload.Autoscaling.Enabled {
+			suffixes = append(suffixes, "-hpa", "-scaledobject")
+		}
+		if workload.TLS != nil && workload.TLS.Enabled {
+			suffixes = append(suffixes, "-tls", "-certificate")
+		}
+		if workload.OAuthProxy != nil && workload.OAuthProxy.Enabled {
+			suffixes = append(suffixes, "-oauth-proxy", "-proxy-tls")
+		}
+	}
+	if engine := r.Spec.Engine; engine != nil && engine.Type == EngineTypeRQ {
+		suffixes = append(suffixes, "-worker")
+		if engine.RQ != nil && engine.RQ.Redis.Managed != nil {
+			suffixes = append(suffixes, "-redis")
+		}
+	}
+	if exposure := r.Spec.Exposure; exposure != nil {
+		if exposure.Route != nil && exposure.Route.Enabled {
+			suffixes = append(suffixes, "-route")
+		}
+		if exposure.Ingress != nil && exposure.Ingress.Enabled {
+			suffixes = append(suffixes, "-ingress")
+		}
+		if exposure.Gateway != nil && exposure.Gateway.Enabled {
+			suffixes = append(suffixes, "-httproute")
+		}
+	}
+	if networkPolicy := r.Spec.NetworkPolicy; networkPolicy != nil && networkPolicy.Mode != "" && networkPolicy.Mode != "none" {
+		suffixes = append(suffixes, "-networkpolicy")
+	}
+	if models := r.Spec.Models; models != nil && models.Enabled && models.CacheName == "" && models.Image == "" {
+		suffixes = append(suffixes, "-models", "-models-download")
+	}
+	return suffixes
+}
@@ -0,0 +1,259 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+var _ = Describe("DoclingServe Webhook", func() {
+	var (
+		obj       *DoclingServe
+		defaulter DoclingServeCustomDefaulter
+		validator DoclingServeCustomValidator
+	)
+
+	BeforeEach(func() {
+		obj = &DoclingServe{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-resource", Namespace: "default"},
+			Spec: DoclingServeSpec{
+				Workload: &Workload{
+					Image:    "quay.io/docling-project/docling-serve:v1.0.0",
+					Replicas: 1,
+				},
+				Engine: &Engine{Type: EngineTypeLocal, Local: &LocalEngine{NumWorkers: 2}},
+			},
+		}
+	})
+
+	Context("When creating DoclingServe under Defaulting Webhook", func() {
+		It("Should fill in the image and the local engine", func() {
+			obj.Spec = DoclingServeSpec{Workload: &Workload{Replicas: 1}}
+
+			Expect(defaulter.Default(context.Background(), obj)).To(Succeed())
+			Expect(obj.Spec.Workload.Image).To(Equal(DefaultImage))
+			Expect(obj.Spec.Engine.Type).To(Equal(EngineTypeLocal))
+			Expect(obj.Spec.Engine.Local.NumWorkers).To(Equal(int32(DefaultNumWorkers)))
+		})
+
+		It("Should admit the default image without warnings", func() {
+			obj.Spec.Workload.Image = ""
+
+			Expect(defaulter.Default(context.Background(), obj)).To(Succeed())
+			warnings, err := validator.ValidateCreate(context.Background(), obj)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(BeEmpty())
+		})
+
+		It("Should not add a local engine to another engine type", func() {
+			obj.Spec.Engine = &Engine{Type: EngineTypeKFP, KFP: &KFPEngine{Endpoint: "https://kfp.example.com:8888"}}
+
+			Expect(defaulter.Default(context.Background(), obj)).To(Succeed())
+			Expect(obj.Spec.Engine.Local).To(BeNil())
+		})
+	})
+
+	Context("When creating or updating DoclingServe under Validating Webhook", func() {
+		It("Should admit a valid resource without warnings", func() {
+			warnings, err := validator.ValidateCreate(context.Background(), obj)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(BeEmpty())
+		})
+
+		It("Should deny a name that makes the derived resource names too long", func() {
+			obj.Name = strings.Repeat("a", 60)
+
+			_, err := validator.ValidateCreate(context.Background(), obj)
+			Expect(apierrors.IsInvalid(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring(obj.Name + "-deployment"))
+		})
+
+		It("Should deny a name that makes the models download job name too long", func() {
+			obj.Name = strings.Repeat("a", 48)
+			obj.Spec.Models = &Models{Enabled: true}
+
+			_, err := validator.ValidateCreate(context.Background(), obj)
+			Expect(apierrors.IsInvalid(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring(obj.Name + "-models-download"))
+
+			obj.Name = strings.Repeat("a", 47)
+			_, err = validator.ValidateCreate(context.Background(), obj)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("Should only check the derived names of the enabled features", func() {
+			obj.Name = strings.Repeat("a", 48)
+
+			_, err := validator.ValidateCreate(context.Background(), obj)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("Should not check the derived names of an existing name", func() {
+			obj.Name = strings.Repeat("a", 60)
+
+			_, err := validator.ValidateUpdate(context.Background(), obj.DeepCopy(), obj)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("Should deny a missing workload", func() {
+			obj.Spec.Workload = nil
+
+			_, err := validator.ValidateCreate(context.Background(), obj)
+			Expect(apierrors.IsInvalid(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("spec.workload"))
+		})
+
+		It("Should deny an invalid KFP endpoint", func() {
+			obj.Spec.Engine = &Engine{Type: EngineTypeKFP, KFP: &KFPEngine{Endpoint: "kfp.example.com"}}
+
+			_, err := validator.ValidateUpdate(context.Background(), obj, obj)
+			Expect(apierrors.IsInvalid(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("spec.engine.kfp.endpoint"))
+		})
+
+		It("Should deny a Redis URL of another scheme", func() {
+			obj.Spec.Engine = &Engine{Type: EngineTypeRQ, RQ: &RQEngine{Workers: 1, Redis: Redis{URL: "https://redis.example.com:6379"}}}
+
+			_, err := validator.ValidateCreate(context.Background(), obj)
+			Expect(apierrors.IsInvalid(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("spec.engine.rq.redis.url"))
+		})
+
+		It("Should deny worker pods without the RQ engine", func() {
+			obj.Spec.Worker = &Worker{PriorityClassName: "docling-workers"}
+
+			_, err := validator.ValidateCreate(context.Background(), obj)
+			Expect(apierrors.IsInvalid(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("spec.worker"))
+		})
+
+		It("Should deny overriding the variables managed by the operator", func() {
+			obj.Spec.Workload.Env = []corev1.EnvVar{
+				{Name: "DOCLING_SERVE_MAX_NUM_PAGES", Value: "100"},
+				{Name: "DOCLING_SERVE_ENG_KIND", Value: "kfp"},
+			}
+
+			_, err := validator.ValidateCreate(context.Background(), obj)
+			Expect(apierrors.IsInvalid(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("spec.workload.env[1].name"))
+			Expect(err.Error()).NotTo(ContainSubstring("spec.workload.env[0].name"))
+		})
+
+		It("Should deny settings the image does not read", func() {
+			obj.Spec.Workload.Image = "quay.io/docling-project/docling-serve:v0.6.0"
+			obj.Spec.Workload.Settings = &Settings{MaxNumPages: ptr.To(int32(50)), AllowedOCREngines: []string{"easyocr"}}
+
+			_, err := validator.ValidateCreate(context.Background(), obj)
+			Expect(apierrors.IsInvalid(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("spec.workload.settings.allowedOCREngines"))
+		})
+
+		It("Should warn about a models image copied by the init container", func() {
+			obj.Spec.Models = &Models{Enabled: true, Image: "registry.example.com/docling-models:v1", ImageMountMode: "InitContainer"}
+
+			warnings, err := validator.ValidateCreate(context.Background(), obj)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf(ContainSubstring("shell userland")))
+
+			obj.Spec.Models.ImageMountMode = "ImageVolume"
+			warnings, err = validator.ValidateCreate(context.Background(), obj)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(BeEmpty())
+		})
+
+		It("Should deny download settings together with a models image", func() {
+			obj.Spec.Models = &Models{Enabled: true, Image: "registry.example.com/docling-models:v1", Bundles: []string{"layout"}}
+
+			_, err := validator.ValidateCreate(context.Background(), obj)
+			Expect(apierrors.IsInvalid(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("spec.models.bundles"))
+		})
+
+		It("Should deny a negative API key rotation interval", func() {
+			obj.Spec.Workload.Authentication = &Authentication{Enabled: true, RotationInterval: &metav1.Duration{Duration: -time.Hour}}
+
+			_, err := validator.ValidateCreate(context.Background(), obj)
+			Expect(apierrors.IsInvalid(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("spec.workload.authentication.rotationInterval"))
+		})
+
+		It("Should deny the OAuth proxy behind an ingress", func() {
+			obj.Spec.Workload.OAuthProxy = &OAuthProxy{Enabled: true}
+			obj.Spec.Exposure = &Exposure{Ingress: &Ingress{Enabled: true}}
+
+			_, err := validator.ValidateCreate(context.Background(), obj)
+			Expect(apierrors.IsInvalid(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("spec.workload.oauthProxy.enabled"))
+		})
+
+		It("Should deny the OAuth proxy with a user service account", func() {
+			obj.Spec.Workload.OAuthProxy = &OAuthProxy{Enabled: true}
+			obj.Spec.Workload.ServiceAccountName = "docling"
+
+			_, err := validator.ValidateCreate(context.Background(), obj)
+			Expect(apierrors.IsInvalid(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("spec.workload.serviceAccountName"))
+		})
+
+		It("Should deny a route timeout together with the timeout annotation", func() {
+			obj.Spec.Exposure = &Exposure{Route: &Route{
+				Enabled:     true,
+				Timeout:     "10m",
+				Annotations: map[string]string{"haproxy.router.openshift.io/timeout": "1h"},
+			}}
+
+			_, err := validator.ValidateCreate(context.Background(), obj)
+			Expect(apierrors.IsInvalid(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("spec.exposure.route.annotations[haproxy.router.openshift.io/timeout]"))
+		})
+
+		It("Should warn about the latest tag of the managed Redis", func() {
+			obj.Spec.Engine = &Engine{Type: EngineTypeRQ, RQ: &RQEngine{Workers: 1, Redis: Redis{Managed: &ManagedRedis{Image: "quay.io/sclorg/redis-7-c9s:latest"}}}}
+
+			warnings, err := validator.ValidateCreate(context.Background(), obj)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf(HavePrefix("spec.engine.rq.redis.managed.image")))
+		})
+
+		It("Should warn when the network policy does not select the ingress controller", func() {
+			obj.Spec.NetworkPolicy = &NetworkPolicy{Mode: "restrictToNamespace"}
+			obj.Spec.Exposure = &Exposure{Ingress: &Ingress{Enabled: true}}
+
+			warnings, err := validator.ValidateCreate(context.Background(), obj)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf(ContainSubstring("spec.networkPolicy.ingressControllerNamespaceSelector")))
+		})
+
+		It("Should warn about the latest tag and zero replicas", func() {
+			obj.Spec.Workload.Image = "quay.io/docling-project/docling-serve"
+			obj.Spec.Workload.Replicas = 0
+
+			warnings, err := validator.ValidateCreate(context.Background(), obj)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf(HavePrefix("spec.workload.image"), HavePrefix("spec.workload.replicas")))
+		})
+	})
+})
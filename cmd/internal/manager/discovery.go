@@ -0,0 +1,64 @@
+package manager
+
+import (
+	routev1 "github.com/openshift/api/route/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/rest"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.io/docling-project/docling-operator/internal/reconcilers"
+)
+
+const (
+	kedaGroupVersion        = "keda.sh/v1alpha1"
+	certManagerGroupVersion = "cert-manager.io/v1"
+	securityGroupVersion    = "security.openshift.io/v1"
+)
+
+// discoverCapabilities queries the API server for the optional APIs the operator can integrate with,
+// so that watches and reconcilers are only registered for resources the cluster actually serves.
+func discoverCapabilities(cfg *rest.Config) (reconcilers.Capabilities, error) {
+	capabilities := reconcilers.Capabilities{}
+
+	discoveryClient, err := discovery.NewDiscoveryClientForConfig(cfg)
+	if err != nil {
+		return capabilities, err
+	}
+
+	if capabilities.Route, err = hasResource(discoveryClient, routev1.GroupVersion.String(), "routes"); err != nil {
+		return capabilities, err
+	}
+	if capabilities.GatewayAPI, err = hasResource(discoveryClient, gatewayv1.GroupVersion.String(), "httproutes"); err != nil {
+		return capabilities, err
+	}
+	if capabilities.KEDA, err = hasResource(discoveryClient, kedaGroupVersion, "scaledobjects"); err != nil {
+		return capabilities, err
+	}
+	if capabilities.CertManager, err = hasResource(discoveryClient, certManagerGroupVersion, "certificates"); err != nil {
+		return capabilities, err
+	}
+	if capabilities.SecurityContextConstraints, err = hasResource(discoveryClient, securityGroupVersion, "securitycontextconstraints"); err != nil {
+		return capabilities, err
+	}
+
+	return capabilities, nil
+}
+
+// hasResource reports whether the API server serves the named resource in the given group version.
+func hasResource(discoveryClient discovery.DiscoveryInterface, groupVersion, resource string) (bool, error) {
+	resources, err := discoveryClient.ServerResourcesForGroupVersion(groupVersion)
+	if apierrors.IsNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	for _, apiResource := range resources.APIResources {
+		if apiResource.Name == resource {
+			return true, nil
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,51 @@
+package manager
+
+import (
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	clienttesting "k8s.io/client-go/testing"
+)
+
+var _ = Describe("Capabilities discovery", func() {
+	var discoveryClient *fakediscovery.FakeDiscovery
+
+	BeforeEach(func() {
+		discoveryClient = &fakediscovery.FakeDiscovery{Fake: &clienttesting.Fake{}}
+		discoveryClient.Resources = []*metav1.APIResourceList{{
+			GroupVersion: "route.openshift.io/v1",
+			APIResources: []metav1.APIResource{{Name: "routes", Kind: "Route"}},
+		}}
+	})
+
+	It("should find a resource of a served group version", func() {
+		found, err := hasResource(discoveryClient, "route.openshift.io/v1", "routes")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(found).To(BeTrue())
+	})
+
+	It("should not find a resource missing from a served group version", func() {
+		found, err := hasResource(discoveryClient, "route.openshift.io/v1", "routes/status")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(found).To(BeFalse())
+	})
+
+	It("should not find a resource of a group version that is not served", func() {
+		found, err := hasResource(discoveryClient, "keda.sh/v1alpha1", "scaledobjects")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(found).To(BeFalse())
+	})
+
+	It("should return the errors of the discovery", func() {
+		discoveryClient.PrependReactor("*", "*", func(clienttesting.Action) (bool, runtime.Object, error) {
+			return true, nil, errors.New("connection refused")
+		})
+
+		_, err := hasResource(discoveryClient, "route.openshift.io/v1", "routes")
+		Expect(err).To(MatchError("connection refused"))
+	})
+})
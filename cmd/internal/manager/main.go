@@ -4,23 +4,28 @@ import (
 	"context"
 	"crypto/tls"
 	"flag"
+	"os"
 
 	// Import all Kubernetes client auth plugins (e.g. Azure, GCP, OIDC, etc.)
 	// to ensure that exec-entrypoint and run can make use of them.
 	_ "k8s.io/client-go/plugin/pkg/client/auth"
 
 	routev1 "github.com/openshift/api/route/v1"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
 	"sigs.k8s.io/controller-runtime/pkg/metrics/filters"
 	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
 
 	doclinggithubiov1alpha1 "github.io/docling-project/docling-operator/api/v1alpha1"
+	doclinggithubiov1beta1 "github.io/docling-project/docling-operator/api/v1beta1"
 	"github.io/docling-project/docling-operator/internal/controller"
 	// +kubebuilder:scaffold:imports
 )
@@ -36,7 +41,11 @@ func init() {
 	// adding the routev1 scheme to support OpenShift kind:Route
 	utilruntime.Must(routev1.AddToScheme(scheme))
 
+	// adding the gatewayv1 scheme to support Gateway API kind:HTTPRoute
+	utilruntime.Must(gatewayv1.AddToScheme(scheme))
+
 	utilruntime.Must(doclinggithubiov1alpha1.AddToScheme(scheme))
+	utilruntime.Must(doclinggithubiov1beta1.AddToScheme(scheme))
 	// +kubebuilder:scaffold:scheme
 }
 
@@ -108,8 +117,24 @@ func Run(ctx context.Context) error {
 		metricsServerOptions.FilterProvider = filters.WithAuthenticationAndAuthorization
 	}
 
-	mgr, err := ctrl.NewManager(ctrl.GetConfigOrDie(), ctrl.Options{
-		Scheme:                 scheme,
+	cfg := ctrl.GetConfigOrDie()
+
+	capabilities, err := discoverCapabilities(cfg)
+	if err != nil {
+		setupLog.Error(err, "unable to discover cluster capabilities")
+		return err
+	}
+	setupLog.Info("discovered cluster capabilities", "route", capabilities.Route,
+		"gatewayAPI", capabilities.GatewayAPI, "keda", capabilities.KEDA, "certManager", capabilities.CertManager,
+		"securityContextConstraints", capabilities.SecurityContextConstraints)
+
+	mgr, err := ctrl.NewManager(cfg, ctrl.Options{
+		Scheme: scheme,
+		// The controllers only watch the metadata of the Secrets and ConfigMaps, their data is read from the API server
+		// so that the manager does not keep every Secret of the cluster in memory.
+		Client: client.Options{
+			Cache: &client.CacheOptions{DisableFor: []client.Object{&corev1.Secret{}, &corev1.ConfigMap{}}},
+		},
 		Metrics:                metricsServerOptions,
 		WebhookServer:          webhookServer,
 		HealthProbeBindAddress: probeAddr,
@@ -133,12 +158,35 @@ func Run(ctx context.Context) error {
 	}
 
 	if err := (&controller.DoclingServeReconciler{
+		Client:       mgr.GetClient(),
+		Scheme:       mgr.GetScheme(),
+		Capabilities: capabilities,
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "DoclingServe")
+		return err
+	}
+	if err := (&controller.DoclingModelCacheReconciler{
 		Client: mgr.GetClient(),
 		Scheme: mgr.GetScheme(),
 	}).SetupWithManager(mgr); err != nil {
-		setupLog.Error(err, "unable to create controller", "controller", "DoclingServe")
+		setupLog.Error(err, "unable to create controller", "controller", "DoclingModelCache")
 		return err
 	}
+	// nolint:goconst
+	if os.Getenv("ENABLE_WEBHOOKS") != "false" {
+		if err = (&doclinggithubiov1alpha1.DoclingServe{}).SetupWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "DoclingServe")
+			return err
+		}
+		if err = (&doclinggithubiov1beta1.DoclingServe{}).SetupWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "DoclingServe")
+			return err
+		}
+		if err = (&doclinggithubiov1alpha1.DoclingModelCache{}).SetupWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "DoclingModelCache")
+			return err
+		}
+	}
 	// +kubebuilder:scaffold:builder
 
 	if err := mgr.AddHealthzCheck("healthz", healthz.Ping); err != nil {
@@ -0,0 +1,14 @@
+package manager
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestManager(t *testing.T) {
+	RegisterFailHandler(Fail)
+
+	RunSpecs(t, "Manager Suite")
+}
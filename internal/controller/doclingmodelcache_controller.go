@@ -0,0 +1,78 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.io/docling-project/docling-operator/api/v1alpha1"
+	"github.io/docling-project/docling-operator/internal/reconcilers"
+)
+
+// DoclingModelCacheReconciler reconciles a DoclingModelCache object
+type DoclingModelCacheReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+}
+
+// +kubebuilder:rbac:groups=docling.github.io,resources=doclingmodelcaches,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=docling.github.io,resources=doclingmodelcaches/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=docling.github.io,resources=doclingmodelcaches/finalizers,verbs=update
+// +kubebuilder:rbac:groups=core,resources=persistentvolumeclaims,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=core,resources=pods,verbs=get;list;watch
+// +kubebuilder:rbac:groups=batch,resources=jobs,verbs=get;list;watch;create;update;patch;delete
+
+// Reconcile provisions the volume of the DoclingModelCache, downloads the models into it and reports them.
+func (r *DoclingModelCacheReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	reqLogger := logf.FromContext(ctx, "Request.Namespace", req.Namespace, "Request.Name", req.Name)
+	reqLogger.Info("Reconciling DoclingModelCache")
+
+	ctx = logf.IntoContext(ctx, reqLogger)
+
+	cache := &v1alpha1.DoclingModelCache{}
+	if err := r.Get(ctx, req.NamespacedName, cache); err != nil {
+		if errors.IsNotFound(err) {
+			// The owned resources are garbage collected with the cache.
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, err
+	}
+
+	requeue, err := reconcilers.NewModelCacheReconciler(r.Client, r.Scheme).Reconcile(ctx, cache.DeepCopy())
+	if err != nil {
+		log.Error(err, "requeuing with error")
+	}
+	return ctrl.Result{Requeue: requeue}, err
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *DoclingModelCacheReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&v1alpha1.DoclingModelCache{}).
+		Owns(&corev1.PersistentVolumeClaim{}).
+		Owns(&batchv1.Job{}).
+		Complete(r)
+}
@@ -0,0 +1,167 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	doclinggithubiov1alpha1 "github.io/docling-project/docling-operator/api/v1alpha1"
+)
+
+var _ = Describe("DoclingModelCache Controller", func() {
+	Context("When reconciling a resource", func() {
+		const resourceName = "test-model-cache"
+
+		ctx := context.Background()
+
+		typeNamespacedName := types.NamespacedName{
+			Name:      resourceName,
+			Namespace: "default",
+		}
+
+		BeforeEach(func() {
+			By("creating the custom resource for the Kind DoclingModelCache")
+			resource := &doclinggithubiov1alpha1.DoclingModelCache{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      resourceName,
+					Namespace: "default",
+				},
+				Spec: doclinggithubiov1alpha1.DoclingModelCacheSpec{
+					Image:   "registry/image:tag",
+					Bundles: []string{"layout", "tableformer"},
+				},
+			}
+			Expect(k8sClient.Create(ctx, resource)).To(Succeed())
+		})
+
+		AfterEach(func() {
+			resource := &doclinggithubiov1alpha1.DoclingModelCache{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+
+			By("Cleanup the specific resource instance DoclingModelCache")
+			Expect(k8sClient.Delete(ctx, resource)).To(Succeed())
+		})
+
+		It("should run the download job with the pod settings of the spec", func() {
+			controllerReconciler := &DoclingModelCacheReconciler{
+				Client: k8sClient,
+				Scheme: k8sClient.Scheme(),
+			}
+
+			By("Setting the security contexts and the scheduling of the download")
+			resource := &doclinggithubiov1alpha1.DoclingModelCache{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			resource.Spec.PodSecurityContext = &corev1.PodSecurityContext{RunAsUser: ptr.To(int64(1000))}
+			resource.Spec.SecurityContext = &corev1.SecurityContext{ReadOnlyRootFilesystem: ptr.To(true)}
+			resource.Spec.NodeSelector = map[string]string{"node-role.kubernetes.io/storage": ""}
+			resource.Spec.Tolerations = []corev1.Toleration{{Key: "storage", Operator: corev1.TolerationOpExists, Effect: corev1.TaintEffectNoSchedule}}
+			Expect(k8sClient.Update(ctx, resource)).To(Succeed())
+
+			_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+
+			job := &batchv1.Job{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: resourceName + "-model-cache-download", Namespace: "default"}, job)).To(Succeed())
+			podSpec := job.Spec.Template.Spec
+			Expect(podSpec.SecurityContext.RunAsUser).To(Equal(ptr.To(int64(1000))))
+			Expect(podSpec.SecurityContext.RunAsNonRoot).To(Equal(ptr.To(true)))
+			Expect(podSpec.Containers[0].SecurityContext.ReadOnlyRootFilesystem).To(Equal(ptr.To(true)))
+			Expect(podSpec.Containers[0].SecurityContext.Capabilities.Drop).To(ConsistOf(corev1.Capability("ALL")))
+			Expect(podSpec.Containers[0].VolumeMounts).To(ContainElement(corev1.VolumeMount{Name: "tmp", MountPath: "/tmp"}))
+			Expect(podSpec.NodeSelector).To(Equal(resource.Spec.NodeSelector))
+			Expect(podSpec.Tolerations).To(Equal(resource.Spec.Tolerations))
+		})
+
+		It("should report the models once they are downloaded", func() {
+			controllerReconciler := &DoclingModelCacheReconciler{
+				Client: k8sClient,
+				Scheme: k8sClient.Scheme(),
+			}
+
+			By("Reconciling the created resource")
+			_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+
+			pvc := &corev1.PersistentVolumeClaim{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: resourceName + "-model-cache", Namespace: "default"}, pvc)).To(Succeed())
+			Expect(pvc.Spec.AccessModes).To(Equal([]corev1.PersistentVolumeAccessMode{corev1.ReadWriteMany}))
+			Expect(pvc.Spec.Resources.Requests.Storage().String()).To(Equal("20Gi"))
+
+			job := &batchv1.Job{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: resourceName + "-model-cache-download", Namespace: "default"}, job)).To(Succeed())
+			container := job.Spec.Template.Spec.Containers[0]
+			Expect(container.Image).To(Equal("registry/image:tag"))
+			Expect(container.Command[len(container.Command)-2:]).To(Equal([]string{"layout", "tableformer"}))
+
+			resource := &doclinggithubiov1alpha1.DoclingModelCache{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			Expect(resource.Status.ClaimName).To(Equal(resourceName + "-model-cache"))
+			Expect(meta.IsStatusConditionFalse(resource.Status.Conditions, "Ready")).To(BeTrue())
+
+			By("Completing the download")
+			pod := &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      resourceName + "-model-cache-download-0",
+					Namespace: "default",
+					Labels:    map[string]string{batchv1.ControllerUidLabel: string(job.UID)},
+				},
+				Spec: job.Spec.Template.Spec,
+			}
+			Expect(k8sClient.Create(ctx, pod)).To(Succeed())
+			pod.Status.Phase = corev1.PodSucceeded
+			pod.Status.ContainerStatuses = []corev1.ContainerStatus{{
+				Name:  "download",
+				Image: container.Image,
+				State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{
+					Message: `{"models":["ds4sd--docling-models"],"sizeKiB":1048576}`,
+				}},
+			}}
+			Expect(k8sClient.Status().Update(ctx, pod)).To(Succeed())
+
+			now := metav1.Now()
+			job.Status.StartTime = &now
+			job.Status.CompletionTime = &now
+			job.Status.Succeeded = 1
+			job.Status.Conditions = []batchv1.JobCondition{
+				{Type: batchv1.JobSuccessCriteriaMet, Status: corev1.ConditionTrue, LastTransitionTime: now},
+				{Type: batchv1.JobComplete, Status: corev1.ConditionTrue, LastTransitionTime: now},
+			}
+			Expect(k8sClient.Status().Update(ctx, job)).To(Succeed())
+
+			_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			Expect(meta.IsStatusConditionTrue(resource.Status.Conditions, "Ready")).To(BeTrue())
+			Expect(resource.Status.Models).To(Equal([]string{"ds4sd--docling-models"}))
+			Expect(resource.Status.Size.String()).To(Equal("1Gi"))
+
+			Expect(k8sClient.Delete(ctx, pod)).To(Succeed())
+		})
+	})
+})
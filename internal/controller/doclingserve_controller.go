@@ -18,16 +18,23 @@ package controller
 
 import (
 	"context"
+	"time"
 
 	routev1 "github.com/openshift/api/route/v1"
 	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
+	ctrlbuilder "sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
 
 	"github.io/docling-project/docling-operator/api/v1alpha1"
 	"github.io/docling-project/docling-operator/internal/reconcilers"
@@ -39,14 +46,30 @@ var log = logf.Log.WithName("controller_doclingserve")
 type DoclingServeReconciler struct {
 	client.Client
 	Scheme *runtime.Scheme
+
+	// Capabilities lists the optional APIs available in the cluster.
+	Capabilities reconcilers.Capabilities
 }
 
 // +kubebuilder:rbac:groups=docling.github.io,resources=doclingserves,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=docling.github.io,resources=doclingserves/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=docling.github.io,resources=doclingserves/finalizers,verbs=update
+// +kubebuilder:rbac:groups=docling.github.io,resources=doclingmodelcaches,verbs=get;list;watch
 // +kubebuilder:rbac:groups=apps,resources=deployments,verbs=get;list;watch;create;update;patch;delete
-// +kubebuilder:rbac:groups=core,resources=pods;services;serviceaccounts,verbs=update;create;get;list;watch
+// +kubebuilder:rbac:groups=apps,resources=statefulsets,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=autoscaling,resources=horizontalpodautoscalers,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=keda.sh,resources=scaledobjects,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=cert-manager.io,resources=certificates,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=core,resources=pods,verbs=update;create;get;list;watch
+// +kubebuilder:rbac:groups=core,resources=services,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=core,resources=serviceaccounts,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=core,resources=secrets,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=core,resources=configmaps,verbs=get;list;watch
+// +kubebuilder:rbac:groups=core,resources=persistentvolumeclaims,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=batch,resources=jobs,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=route.openshift.io,resources=routes;routes/custom-host,verbs=*
+// +kubebuilder:rbac:groups=networking.k8s.io,resources=ingresses;networkpolicies,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=httproutes,verbs=get;list;watch;create;update;patch;delete
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
 // move the current state of the cluster closer to the desired state.
@@ -74,13 +97,33 @@ func (r *DoclingServeReconciler) Reconcile(ctx context.Context, req ctrl.Request
 
 	resourceReconcilers := []reconcilers.Reconciler{
 		reconcilers.NewServiceAccountReconciler(r.Client, r.Scheme),
-		reconcilers.NewDeploymentReconciler(r.Client, r.Scheme),
-		reconcilers.NewServiceReconciler(r.Client, r.Scheme),
-		reconcilers.NewRouteReconciler(r.Client, r.Scheme),
-		reconcilers.NewStatusReconciler(r.Client, r.Scheme),
+		reconcilers.NewAPIKeySecretReconciler(r.Client, r.Scheme),
+		reconcilers.NewOAuthProxySecretReconciler(r.Client, r.Scheme),
+		reconcilers.NewRedisReconciler(r.Client, r.Scheme),
+		reconcilers.NewModelsReconciler(r.Client, r.Scheme),
+		reconcilers.NewDeploymentReconciler(r.Client, r.Scheme, r.Capabilities),
+		reconcilers.NewWorkerDeploymentReconciler(r.Client, r.Scheme, r.Capabilities),
+		reconcilers.NewHorizontalPodAutoscalerReconciler(r.Client, r.Scheme),
+	}
+	if r.Capabilities.KEDA {
+		resourceReconcilers = append(resourceReconcilers, reconcilers.NewScaledObjectReconciler(r.Client, r.Scheme))
+	}
+	if r.Capabilities.CertManager {
+		resourceReconcilers = append(resourceReconcilers, reconcilers.NewCertificateReconciler(r.Client, r.Scheme))
+	}
+	resourceReconcilers = append(resourceReconcilers, reconcilers.NewServiceReconciler(r.Client, r.Scheme))
+	resourceReconcilers = append(resourceReconcilers, reconcilers.NewNetworkPolicyReconciler(r.Client, r.Scheme))
+	if r.Capabilities.Route {
+		resourceReconcilers = append(resourceReconcilers, reconcilers.NewRouteReconciler(r.Client, r.Scheme))
 	}
+	resourceReconcilers = append(resourceReconcilers, reconcilers.NewIngressReconciler(r.Client, r.Scheme))
+	if r.Capabilities.GatewayAPI {
+		resourceReconcilers = append(resourceReconcilers, reconcilers.NewHTTPRouteReconciler(r.Client, r.Scheme))
+	}
+	resourceReconcilers = append(resourceReconcilers, reconcilers.NewStatusReconciler(r.Client, r.Scheme, r.Capabilities))
 
 	requeueResult := false
+	var requeueAfter time.Duration
 	var errResult error = nil
 	doclingServe := currentDoclingServe.DeepCopy()
 	for _, r := range resourceReconcilers {
@@ -91,17 +134,58 @@ func (r *DoclingServeReconciler) Reconcile(ctx context.Context, req ctrl.Request
 			errResult = err
 		}
 		requeueResult = requeueResult || reque
+		if scheduled, ok := r.(reconcilers.ScheduledReconciler); ok {
+			if after := scheduled.RequeueAfter(); after > 0 && (requeueAfter == 0 || after < requeueAfter) {
+				requeueAfter = after
+			}
+		}
 	}
 
-	return ctrl.Result{Requeue: requeueResult}, errResult
+	return ctrl.Result{Requeue: requeueResult, RequeueAfter: requeueAfter}, errResult
 }
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *DoclingServeReconciler) SetupWithManager(mgr ctrl.Manager) error {
-	return ctrl.NewControllerManagedBy(mgr).
+	if err := mgr.GetFieldIndexer().IndexField(context.Background(), &v1alpha1.DoclingServe{}, secretReferenceIndexKey, referencedSecrets); err != nil {
+		return err
+	}
+	if err := mgr.GetFieldIndexer().IndexField(context.Background(), &v1alpha1.DoclingServe{}, configMapReferenceIndexKey, referencedConfigMaps); err != nil {
+		return err
+	}
+	if err := mgr.GetFieldIndexer().IndexField(context.Background(), &v1alpha1.DoclingServe{}, modelCacheReferenceIndexKey, referencedModelCache); err != nil {
+		return err
+	}
+
+	// Secrets and ConfigMaps are only watched by their metadata, the manager reads them from the API server.
+	builder := ctrl.NewControllerManagedBy(mgr).
 		For(&v1alpha1.DoclingServe{}).
 		Owns(&appsv1.Deployment{}).
+		Owns(&appsv1.StatefulSet{}).
+		Owns(&autoscalingv2.HorizontalPodAutoscaler{}).
 		Owns(&corev1.Service{}).
-		Owns(&routev1.Route{}).
-		Complete(r)
+		Owns(&corev1.ServiceAccount{}).
+		Owns(&corev1.Secret{}, ctrlbuilder.OnlyMetadata).
+		Owns(&corev1.PersistentVolumeClaim{}).
+		Owns(&batchv1.Job{}).
+		Owns(&networkingv1.Ingress{}).
+		Owns(&networkingv1.NetworkPolicy{}).
+		Watches(&corev1.Secret{}, handler.EnqueueRequestsFromMapFunc(r.doclingServesForSecret), ctrlbuilder.OnlyMetadata).
+		Watches(&corev1.ConfigMap{}, handler.EnqueueRequestsFromMapFunc(r.doclingServesForConfigMap), ctrlbuilder.OnlyMetadata).
+		Watches(&v1alpha1.DoclingModelCache{}, handler.EnqueueRequestsFromMapFunc(r.doclingServesForModelCache))
+
+	// Optional APIs are only watched when the cluster serves them, otherwise the manager fails to start.
+	if r.Capabilities.Route {
+		builder = builder.Owns(&routev1.Route{})
+	}
+	if r.Capabilities.GatewayAPI {
+		builder = builder.Owns(&gatewayv1.HTTPRoute{})
+	}
+	if r.Capabilities.KEDA {
+		builder = builder.Owns(reconcilers.NewScaledObject())
+	}
+	if r.Capabilities.CertManager {
+		builder = builder.Owns(reconcilers.NewCertificate())
+	}
+
+	return builder.Complete(r)
 }
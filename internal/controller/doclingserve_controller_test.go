@@ -18,16 +18,32 @@ package controller
 
 import (
 	"context"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	routev1 "github.com/openshift/api/route/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	doclinggithubiov1alpha1 "github.io/docling-project/docling-operator/api/v1alpha1"
+	"github.io/docling-project/docling-operator/internal/reconcilers"
 )
 
 var _ = Describe("DoclingServe Controller", func() {
@@ -111,4 +127,1913 @@ var _ = Describe("DoclingServe Controller", func() {
 			}
 		})
 	})
+
+	Context("When configuring the environment of the resource", func() {
+		const resourceName = "test-environment"
+
+		ctx := context.Background()
+
+		typeNamespacedName := types.NamespacedName{
+			Name:      resourceName,
+			Namespace: "default",
+		}
+
+		BeforeEach(func() {
+			By("creating the custom resource for the Kind DoclingServe with environment variables")
+			resource := &doclinggithubiov1alpha1.DoclingServe{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      resourceName,
+					Namespace: "default",
+				},
+				Spec: doclinggithubiov1alpha1.DoclingServeSpec{
+					APIServer: &doclinggithubiov1alpha1.APIServer{
+						Image:         "registry/image:tag",
+						ConfigMapName: "docling-config",
+						Settings: &doclinggithubiov1alpha1.Settings{
+							MaxNumPages: ptr.To(int32(50)),
+							CORSOrigins: []string{"https://docling.example.com"},
+						},
+						Env: []corev1.EnvVar{{
+							Name: "HF_TOKEN",
+							ValueFrom: &corev1.EnvVarSource{
+								SecretKeyRef: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "docling-tokens"}, Key: "hf"},
+							},
+						}},
+						EnvFrom: []corev1.EnvFromSource{{
+							SecretRef: &corev1.SecretEnvSource{LocalObjectReference: corev1.LocalObjectReference{Name: "docling-settings"}},
+						}},
+					},
+					Engine: &doclinggithubiov1alpha1.Engine{
+						Local: &doclinggithubiov1alpha1.Local{NumWorkers: 2},
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, resource)).To(Succeed())
+		})
+
+		AfterEach(func() {
+			resource := &doclinggithubiov1alpha1.DoclingServe{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+
+			By("Cleanup the specific resource instance DoclingServe")
+			Expect(k8sClient.Delete(ctx, resource)).To(Succeed())
+		})
+
+		It("should add the user variables after the settings and the operator ones", func() {
+			controllerReconciler := &DoclingServeReconciler{
+				Client: k8sClient,
+				Scheme: k8sClient.Scheme(),
+			}
+
+			By("Reconciling the created resource")
+			_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+
+			deployment := &appsv1.Deployment{}
+			deploymentName := types.NamespacedName{Name: resourceName + "-deployment", Namespace: "default"}
+			Expect(k8sClient.Get(ctx, deploymentName, deployment)).To(Succeed())
+
+			container := deployment.Spec.Template.Spec.Containers[0]
+			Expect(container.Env).To(HaveLen(4))
+			Expect(container.Env[0]).To(Equal(corev1.EnvVar{Name: "DOCLING_SERVE_MAX_NUM_PAGES", Value: "50"}))
+			Expect(container.Env[1]).To(Equal(corev1.EnvVar{Name: "DOCLING_SERVE_CORS_ORIGINS", Value: `["https://docling.example.com"]`}))
+			Expect(container.Env[2].Name).To(Equal("DOCLING_SERVE_ENG_LOC_NUM_WORKERS"))
+			Expect(container.Env[3].Name).To(Equal("HF_TOKEN"))
+			Expect(container.Env[3].ValueFrom.SecretKeyRef.Name).To(Equal("docling-tokens"))
+			Expect(container.EnvFrom).To(HaveLen(2))
+			Expect(container.EnvFrom[0].ConfigMapRef.Name).To(Equal("docling-config"))
+			Expect(container.EnvFrom[1].SecretRef.Name).To(Equal("docling-settings"))
+		})
+	})
+
+	Context("When changing the configuration referenced by the resource", func() {
+		const resourceName = "test-confighash"
+
+		ctx := context.Background()
+
+		typeNamespacedName := types.NamespacedName{
+			Name:      resourceName,
+			Namespace: "default",
+		}
+
+		BeforeEach(func() {
+			By("creating the custom resource for the Kind DoclingServe with a config map and a missing secret")
+			configMap := &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: resourceName + "-config", Namespace: "default"},
+				Data:       map[string]string{"DOCLING_SERVE_MAX_NUM_PAGES": "100"},
+			}
+			Expect(k8sClient.Create(ctx, configMap)).To(Succeed())
+
+			resource := &doclinggithubiov1alpha1.DoclingServe{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      resourceName,
+					Namespace: "default",
+				},
+				Spec: doclinggithubiov1alpha1.DoclingServeSpec{
+					APIServer: &doclinggithubiov1alpha1.APIServer{
+						Image:         "registry/image:tag",
+						ConfigMapName: resourceName + "-config",
+						EnvFrom: []corev1.EnvFromSource{{
+							SecretRef: &corev1.SecretEnvSource{LocalObjectReference: corev1.LocalObjectReference{Name: resourceName + "-secrets"}},
+						}},
+					},
+					Engine: &doclinggithubiov1alpha1.Engine{
+						Local: &doclinggithubiov1alpha1.Local{},
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, resource)).To(Succeed())
+		})
+
+		AfterEach(func() {
+			resource := &doclinggithubiov1alpha1.DoclingServe{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+
+			By("Cleanup the specific resource instance DoclingServe")
+			Expect(k8sClient.Delete(ctx, resource)).To(Succeed())
+			Expect(k8sClient.Delete(ctx, &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: resourceName + "-config", Namespace: "default"}})).To(Succeed())
+			Expect(client.IgnoreNotFound(k8sClient.Delete(ctx, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: resourceName + "-secrets", Namespace: "default"}}))).To(Succeed())
+		})
+
+		It("should roll the pods out when the configuration changes", func() {
+			controllerReconciler := &DoclingServeReconciler{
+				Client: k8sClient,
+				Scheme: k8sClient.Scheme(),
+			}
+
+			By("Reconciling the created resource")
+			_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+
+			resource := &doclinggithubiov1alpha1.DoclingServe{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			condition := meta.FindStatusCondition(resource.Status.Conditions, "ConfigReferencesResolved")
+			Expect(condition).NotTo(BeNil())
+			Expect(condition.Status).To(Equal(metav1.ConditionFalse))
+			Expect(condition.Message).To(ContainSubstring("Secret " + resourceName + "-secrets"))
+
+			deployment := &appsv1.Deployment{}
+			deploymentName := types.NamespacedName{Name: resourceName + "-deployment", Namespace: "default"}
+			Expect(k8sClient.Get(ctx, deploymentName, deployment)).To(Succeed())
+			hash := deployment.Spec.Template.Annotations["docling.github.io/config-hash"]
+			Expect(hash).NotTo(BeEmpty())
+
+			By("Creating the missing secret and changing the config map")
+			secret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: resourceName + "-secrets", Namespace: "default"},
+				StringData: map[string]string{"HF_TOKEN": "token"},
+			}
+			Expect(k8sClient.Create(ctx, secret)).To(Succeed())
+			configMap := &corev1.ConfigMap{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: resourceName + "-config", Namespace: "default"}, configMap)).To(Succeed())
+			configMap.Data["DOCLING_SERVE_MAX_NUM_PAGES"] = "200"
+			Expect(k8sClient.Update(ctx, configMap)).To(Succeed())
+
+			_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			Expect(meta.IsStatusConditionTrue(resource.Status.Conditions, "ConfigReferencesResolved")).To(BeTrue())
+			Expect(k8sClient.Get(ctx, deploymentName, deployment)).To(Succeed())
+			Expect(deployment.Spec.Template.Annotations["docling.github.io/config-hash"]).NotTo(Equal(hash))
+		})
+	})
+
+	Context("When hardening the security context of the resource", func() {
+		const resourceName = "test-securitycontext"
+
+		ctx := context.Background()
+
+		typeNamespacedName := types.NamespacedName{
+			Name:      resourceName,
+			Namespace: "default",
+		}
+
+		BeforeEach(func() {
+			By("creating the custom resource for the Kind DoclingServe with a read-only root filesystem")
+			resource := &doclinggithubiov1alpha1.DoclingServe{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      resourceName,
+					Namespace: "default",
+				},
+				Spec: doclinggithubiov1alpha1.DoclingServeSpec{
+					APIServer: &doclinggithubiov1alpha1.APIServer{
+						Image:              "registry/image:tag",
+						PodSecurityContext: &corev1.PodSecurityContext{FSGroup: ptr.To(int64(1001))},
+						SecurityContext:    &corev1.SecurityContext{ReadOnlyRootFilesystem: ptr.To(true)},
+					},
+					Engine: &doclinggithubiov1alpha1.Engine{
+						Local: &doclinggithubiov1alpha1.Local{},
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, resource)).To(Succeed())
+		})
+
+		AfterEach(func() {
+			resource := &doclinggithubiov1alpha1.DoclingServe{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+
+			By("Cleanup the specific resource instance DoclingServe")
+			Expect(k8sClient.Delete(ctx, resource)).To(Succeed())
+		})
+
+		It("should keep the restricted defaults under the overrides", func() {
+			controllerReconciler := &DoclingServeReconciler{
+				Client: k8sClient,
+				Scheme: k8sClient.Scheme(),
+			}
+
+			By("Reconciling the created resource")
+			_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+
+			deployment := &appsv1.Deployment{}
+			deploymentName := types.NamespacedName{Name: resourceName + "-deployment", Namespace: "default"}
+			Expect(k8sClient.Get(ctx, deploymentName, deployment)).To(Succeed())
+
+			podSecurityContext := deployment.Spec.Template.Spec.SecurityContext
+			Expect(podSecurityContext.RunAsNonRoot).To(HaveValue(BeTrue()))
+			Expect(podSecurityContext.SeccompProfile.Type).To(Equal(corev1.SeccompProfileTypeRuntimeDefault))
+			Expect(podSecurityContext.FSGroup).To(HaveValue(Equal(int64(1001))))
+
+			container := deployment.Spec.Template.Spec.Containers[0]
+			Expect(container.SecurityContext.AllowPrivilegeEscalation).To(HaveValue(BeFalse()))
+			Expect(container.SecurityContext.Capabilities.Drop).To(ConsistOf(corev1.Capability("ALL")))
+			Expect(container.SecurityContext.ReadOnlyRootFilesystem).To(HaveValue(BeTrue()))
+			Expect(container.VolumeMounts).To(ContainElements(
+				HaveField("MountPath", "/tmp"),
+				HaveField("MountPath", "/var/cache/docling-serve"),
+			))
+			Expect(container.Env).To(ContainElement(corev1.EnvVar{Name: "XDG_CACHE_HOME", Value: "/var/cache/docling-serve"}))
+		})
+	})
+
+	Context("When scheduling the resource on dedicated nodes", func() {
+		const resourceName = "test-scheduling"
+
+		ctx := context.Background()
+
+		typeNamespacedName := types.NamespacedName{
+			Name:      resourceName,
+			Namespace: "default",
+		}
+
+		BeforeEach(func() {
+			By("creating the custom resource for the Kind DoclingServe with scheduling constraints")
+			resource := &doclinggithubiov1alpha1.DoclingServe{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      resourceName,
+					Namespace: "default",
+				},
+				Spec: doclinggithubiov1alpha1.DoclingServeSpec{
+					APIServer: &doclinggithubiov1alpha1.APIServer{
+						Image:        "registry/image:tag",
+						Instances:    2,
+						NodeSelector: map[string]string{"node-pool": "docling"},
+						Tolerations: []corev1.Toleration{{
+							Key:      "dedicated",
+							Operator: corev1.TolerationOpEqual,
+							Value:    "docling",
+							Effect:   corev1.TaintEffectNoSchedule,
+						}},
+						TopologySpreadConstraints: []corev1.TopologySpreadConstraint{{
+							MaxSkew:           1,
+							TopologyKey:       corev1.LabelTopologyZone,
+							WhenUnsatisfiable: corev1.ScheduleAnyway,
+						}},
+					},
+					Engine: &doclinggithubiov1alpha1.Engine{
+						Local: &doclinggithubiov1alpha1.Local{},
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, resource)).To(Succeed())
+		})
+
+		AfterEach(func() {
+			resource := &doclinggithubiov1alpha1.DoclingServe{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+
+			By("Cleanup the specific resource instance DoclingServe")
+			Expect(k8sClient.Delete(ctx, resource)).To(Succeed())
+		})
+
+		It("should spread the pods across nodes and zones", func() {
+			controllerReconciler := &DoclingServeReconciler{
+				Client: k8sClient,
+				Scheme: k8sClient.Scheme(),
+			}
+
+			By("Reconciling the created resource")
+			_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+
+			deployment := &appsv1.Deployment{}
+			deploymentName := types.NamespacedName{Name: resourceName + "-deployment", Namespace: "default"}
+			Expect(k8sClient.Get(ctx, deploymentName, deployment)).To(Succeed())
+
+			podSpec := deployment.Spec.Template.Spec
+			Expect(podSpec.NodeSelector).To(HaveKeyWithValue("node-pool", "docling"))
+			Expect(podSpec.Tolerations).To(HaveLen(1))
+			Expect(podSpec.Affinity.PodAntiAffinity.PreferredDuringSchedulingIgnoredDuringExecution).To(HaveLen(1))
+			term := podSpec.Affinity.PodAntiAffinity.PreferredDuringSchedulingIgnoredDuringExecution[0].PodAffinityTerm
+			Expect(term.TopologyKey).To(Equal(corev1.LabelHostname))
+			Expect(term.LabelSelector.MatchLabels).To(HaveKeyWithValue("doclingserve_cr", resourceName))
+			Expect(podSpec.TopologySpreadConstraints).To(HaveLen(1))
+			Expect(podSpec.TopologySpreadConstraints[0].LabelSelector.MatchLabels).To(HaveKeyWithValue("doclingserve_cr", resourceName))
+
+			By("Scaling down to a single instance")
+			resource := &doclinggithubiov1alpha1.DoclingServe{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			resource.Spec.APIServer.Instances = 1
+			Expect(k8sClient.Update(ctx, resource)).To(Succeed())
+
+			_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(k8sClient.Get(ctx, deploymentName, deployment)).To(Succeed())
+			Expect(deployment.Spec.Template.Spec.Affinity).To(BeNil())
+		})
+	})
+
+	Context("When preloading the models of the resource", func() {
+		const resourceName = "test-models"
+
+		ctx := context.Background()
+
+		typeNamespacedName := types.NamespacedName{
+			Name:      resourceName,
+			Namespace: "default",
+		}
+
+		BeforeEach(func() {
+			By("creating the custom resource for the Kind DoclingServe with preloaded models")
+			resource := &doclinggithubiov1alpha1.DoclingServe{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      resourceName,
+					Namespace: "default",
+				},
+				Spec: doclinggithubiov1alpha1.DoclingServeSpec{
+					APIServer: &doclinggithubiov1alpha1.APIServer{
+						Image:     "registry/image:tag",
+						Instances: 2,
+					},
+					Engine: &doclinggithubiov1alpha1.Engine{
+						Local: &doclinggithubiov1alpha1.Local{},
+					},
+					Models: &doclinggithubiov1alpha1.Models{
+						Enabled: true,
+						Bundles: []string{"layout", "tableformer"},
+						HFTokenSecretRef: &corev1.SecretKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{Name: "hf-token"},
+							Key:                  "token",
+						},
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, resource)).To(Succeed())
+		})
+
+		AfterEach(func() {
+			resource := &doclinggithubiov1alpha1.DoclingServe{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+
+			By("Cleanup the specific resource instance DoclingServe")
+			Expect(k8sClient.Delete(ctx, resource)).To(Succeed())
+		})
+
+		It("should roll the deployment out once the models are downloaded", func() {
+			controllerReconciler := &DoclingServeReconciler{
+				Client: k8sClient,
+				Scheme: k8sClient.Scheme(),
+			}
+
+			By("Reconciling the created resource")
+			_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+
+			pvc := &corev1.PersistentVolumeClaim{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: resourceName + "-models", Namespace: "default"}, pvc)).To(Succeed())
+			Expect(pvc.Spec.Resources.Requests.Storage().String()).To(Equal("10Gi"))
+
+			job := &batchv1.Job{}
+			jobName := types.NamespacedName{Name: resourceName + "-models-download", Namespace: "default"}
+			Expect(k8sClient.Get(ctx, jobName, job)).To(Succeed())
+			container := job.Spec.Template.Spec.Containers[0]
+			Expect(container.Command).To(Equal([]string{"docling-tools"}))
+			Expect(container.Args).To(Equal([]string{"models", "download", "--output-dir", "/models", "layout", "tableformer"}))
+			Expect(container.Env[0].ValueFrom.SecretKeyRef.Name).To(Equal("hf-token"))
+			colocation := job.Spec.Template.Spec.Affinity.PodAffinity.RequiredDuringSchedulingIgnoredDuringExecution
+			Expect(colocation).To(HaveLen(1))
+			Expect(colocation[0].TopologyKey).To(Equal(corev1.LabelHostname))
+			Expect(colocation[0].LabelSelector.MatchExpressions[0].Values).To(ConsistOf("docling-serve", "docling-serve-worker", "docling-serve-models"))
+
+			deployment := &appsv1.Deployment{}
+			deploymentName := types.NamespacedName{Name: resourceName + "-deployment", Namespace: "default"}
+			err = k8sClient.Get(ctx, deploymentName, deployment)
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+
+			resource := &doclinggithubiov1alpha1.DoclingServe{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			Expect(meta.IsStatusConditionFalse(resource.Status.Conditions, "ModelsReady")).To(BeTrue())
+			Expect(meta.IsStatusConditionTrue(resource.Status.Conditions, "Progressing")).To(BeTrue())
+			Expect(meta.IsStatusConditionFalse(resource.Status.Conditions, "Degraded")).To(BeTrue())
+
+			By("Completing the download")
+			now := metav1.Now()
+			job.Status.StartTime = &now
+			job.Status.CompletionTime = &now
+			job.Status.Succeeded = 1
+			job.Status.Conditions = []batchv1.JobCondition{
+				{Type: batchv1.JobSuccessCriteriaMet, Status: corev1.ConditionTrue, LastTransitionTime: now},
+				{Type: batchv1.JobComplete, Status: corev1.ConditionTrue, LastTransitionTime: now},
+			}
+			Expect(k8sClient.Status().Update(ctx, job)).To(Succeed())
+
+			_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(k8sClient.Get(ctx, deploymentName, deployment)).To(Succeed())
+			Expect(deployment.Spec.Template.Spec.Containers[0].Env).To(ContainElement(corev1.EnvVar{Name: "DOCLING_SERVE_ARTIFACTS_PATH", Value: "/models"}))
+			Expect(deployment.Spec.Template.Spec.Containers[0].VolumeMounts).To(ContainElement(corev1.VolumeMount{Name: "models", MountPath: "/models", ReadOnly: true}))
+			By("Running the replicas on the node of the ReadWriteOnce volume")
+			Expect(deployment.Spec.Template.Spec.Affinity.PodAntiAffinity).To(BeNil())
+			Expect(deployment.Spec.Template.Spec.Affinity.PodAffinity.RequiredDuringSchedulingIgnoredDuringExecution).To(Equal(colocation))
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			Expect(meta.IsStatusConditionTrue(resource.Status.Conditions, "ModelsReady")).To(BeTrue())
+
+			By("Upgrading docling-serve without downloading the models again")
+			resource.Spec.APIServer.Image = "quay.io/docling-project/docling-serve:v1.1.0"
+			Expect(k8sClient.Update(ctx, resource)).To(Succeed())
+			_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			downloaded := job.UID
+			Expect(k8sClient.Get(ctx, jobName, job)).To(Succeed())
+			Expect(job.UID).To(Equal(downloaded))
+			Expect(k8sClient.Get(ctx, deploymentName, deployment)).To(Succeed())
+			Expect(deployment.Spec.Template.Spec.Containers[0].Image).To(Equal("quay.io/docling-project/docling-serve:v1.1.0"))
+
+			By("Updating the deployment while other bundles are downloaded")
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			resource.Spec.Models.Bundles = []string{"layout"}
+			resource.Spec.APIServer.Instances = 3
+			Expect(k8sClient.Update(ctx, resource)).To(Succeed())
+			_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(k8sClient.Get(ctx, deploymentName, deployment)).To(Succeed())
+			Expect(*deployment.Spec.Replicas).To(Equal(int32(3)))
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			Expect(meta.IsStatusConditionFalse(resource.Status.Conditions, "ModelsReady")).To(BeTrue())
+		})
+	})
+
+	Context("When mounting the models of the resource from an image", func() {
+		const resourceName = "test-models-image"
+
+		ctx := context.Background()
+
+		typeNamespacedName := types.NamespacedName{
+			Name:      resourceName,
+			Namespace: "default",
+		}
+
+		BeforeEach(func() {
+			By("creating the custom resource for the Kind DoclingServe with a models image")
+			resource := &doclinggithubiov1alpha1.DoclingServe{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      resourceName,
+					Namespace: "default",
+				},
+				Spec: doclinggithubiov1alpha1.DoclingServeSpec{
+					APIServer: &doclinggithubiov1alpha1.APIServer{
+						Image: "registry/image:tag",
+					},
+					Engine: &doclinggithubiov1alpha1.Engine{
+						Local: &doclinggithubiov1alpha1.Local{},
+					},
+					Models: &doclinggithubiov1alpha1.Models{
+						Enabled:   true,
+						Image:     "registry/models:tag",
+						ImagePath: "/opt/models",
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, resource)).To(Succeed())
+		})
+
+		AfterEach(func() {
+			resource := &doclinggithubiov1alpha1.DoclingServe{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+
+			By("Cleanup the specific resource instance DoclingServe")
+			Expect(k8sClient.Delete(ctx, resource)).To(Succeed())
+		})
+
+		It("should copy the models of the image without downloading them", func() {
+			controllerReconciler := &DoclingServeReconciler{
+				Client: k8sClient,
+				Scheme: k8sClient.Scheme(),
+			}
+
+			By("Reconciling the created resource")
+			_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+
+			job := &batchv1.Job{}
+			err = k8sClient.Get(ctx, types.NamespacedName{Name: resourceName + "-models-download", Namespace: "default"}, job)
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+
+			deployment := &appsv1.Deployment{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: resourceName + "-deployment", Namespace: "default"}, deployment)).To(Succeed())
+			podSpec := deployment.Spec.Template.Spec
+			Expect(podSpec.InitContainers).To(HaveLen(1))
+			Expect(podSpec.InitContainers[0].Image).To(Equal("registry/models:tag"))
+			Expect(podSpec.InitContainers[0].Command).To(Equal([]string{"cp", "-R", "/opt/models/.", "/docling-models"}))
+			Expect(podSpec.InitContainers[0].SecurityContext.RunAsNonRoot).To(Equal(ptr.To(true)))
+			Expect(podSpec.InitContainers[0].SecurityContext.RunAsUser).To(Equal(ptr.To(int64(1001))))
+			Expect(podSpec.Containers[0].SecurityContext.RunAsUser).To(BeNil())
+			Expect(podSpec.Volumes).To(ContainElement(corev1.Volume{
+				Name:         "models",
+				VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}},
+			}))
+			Expect(podSpec.Containers[0].Env).To(ContainElement(corev1.EnvVar{Name: "DOCLING_SERVE_ARTIFACTS_PATH", Value: "/models"}))
+
+			By("Mounting the image as a volume")
+			resource := &doclinggithubiov1alpha1.DoclingServe{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			Expect(meta.IsStatusConditionTrue(resource.Status.Conditions, "ModelsReady")).To(BeTrue())
+			resource.Spec.Models.ImageMountMode = "ImageVolume"
+			Expect(k8sClient.Update(ctx, resource)).To(Succeed())
+
+			_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: resourceName + "-deployment", Namespace: "default"}, deployment)).To(Succeed())
+			podSpec = deployment.Spec.Template.Spec
+			Expect(podSpec.InitContainers).To(BeEmpty())
+			Expect(podSpec.Volumes).To(ContainElement(corev1.Volume{
+				Name: "models",
+				VolumeSource: corev1.VolumeSource{
+					Image: &corev1.ImageVolumeSource{Reference: "registry/models:tag", PullPolicy: corev1.PullIfNotPresent},
+				},
+			}))
+			Expect(podSpec.Containers[0].Env).To(ContainElement(corev1.EnvVar{Name: "DOCLING_SERVE_ARTIFACTS_PATH", Value: "/models/opt/models"}))
+		})
+
+		It("should leave the user of the init container to the security context constraints", func() {
+			controllerReconciler := &DoclingServeReconciler{
+				Client:       k8sClient,
+				Scheme:       k8sClient.Scheme(),
+				Capabilities: reconcilers.Capabilities{SecurityContextConstraints: true},
+			}
+
+			By("Reconciling the created resource on OpenShift")
+			_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+
+			deployment := &appsv1.Deployment{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: resourceName + "-deployment", Namespace: "default"}, deployment)).To(Succeed())
+			Expect(deployment.Spec.Template.Spec.InitContainers).To(HaveLen(1))
+			Expect(deployment.Spec.Template.Spec.InitContainers[0].SecurityContext.RunAsUser).To(BeNil())
+		})
+	})
+
+	Context("When mounting a shared model cache in the resource", func() {
+		const resourceName = "test-model-cache-ref"
+		const cacheName = "test-shared-models"
+
+		ctx := context.Background()
+
+		typeNamespacedName := types.NamespacedName{
+			Name:      resourceName,
+			Namespace: "default",
+		}
+		cacheNamespacedName := types.NamespacedName{
+			Name:      cacheName,
+			Namespace: "default",
+		}
+
+		BeforeEach(func() {
+			By("creating the custom resources for the Kinds DoclingModelCache and DoclingServe")
+			cache := &doclinggithubiov1alpha1.DoclingModelCache{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      cacheName,
+					Namespace: "default",
+				},
+				Spec: doclinggithubiov1alpha1.DoclingModelCacheSpec{
+					Image: "registry/image:tag",
+				},
+			}
+			Expect(k8sClient.Create(ctx, cache)).To(Succeed())
+
+			resource := &doclinggithubiov1alpha1.DoclingServe{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      resourceName,
+					Namespace: "default",
+				},
+				Spec: doclinggithubiov1alpha1.DoclingServeSpec{
+					APIServer: &doclinggithubiov1alpha1.APIServer{
+						Image: "registry/image:tag",
+					},
+					Engine: &doclinggithubiov1alpha1.Engine{
+						Local: &doclinggithubiov1alpha1.Local{},
+					},
+					Models: &doclinggithubiov1alpha1.Models{
+						Enabled:   true,
+						CacheName: cacheName,
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, resource)).To(Succeed())
+		})
+
+		AfterEach(func() {
+			resource := &doclinggithubiov1alpha1.DoclingServe{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+
+			By("Cleanup the specific resource instance DoclingServe")
+			Expect(k8sClient.Delete(ctx, resource)).To(Succeed())
+
+			cache := &doclinggithubiov1alpha1.DoclingModelCache{}
+			Expect(k8sClient.Get(ctx, cacheNamespacedName, cache)).To(Succeed())
+			Expect(k8sClient.Delete(ctx, cache)).To(Succeed())
+		})
+
+		It("should roll the deployment out once the cache is ready", func() {
+			controllerReconciler := &DoclingServeReconciler{
+				Client: k8sClient,
+				Scheme: k8sClient.Scheme(),
+			}
+
+			By("Reconciling the created resource")
+			_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+
+			pvc := &corev1.PersistentVolumeClaim{}
+			err = k8sClient.Get(ctx, types.NamespacedName{Name: resourceName + "-models", Namespace: "default"}, pvc)
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+
+			deployment := &appsv1.Deployment{}
+			deploymentName := types.NamespacedName{Name: resourceName + "-deployment", Namespace: "default"}
+			err = k8sClient.Get(ctx, deploymentName, deployment)
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+
+			resource := &doclinggithubiov1alpha1.DoclingServe{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			condition := meta.FindStatusCondition(resource.Status.Conditions, "ModelsReady")
+			Expect(condition).NotTo(BeNil())
+			Expect(condition.Reason).To(Equal("ModelsDownloading"))
+
+			By("Marking the cache as ready")
+			cache := &doclinggithubiov1alpha1.DoclingModelCache{}
+			Expect(k8sClient.Get(ctx, cacheNamespacedName, cache)).To(Succeed())
+			meta.SetStatusCondition(&cache.Status.Conditions, metav1.Condition{
+				Type:               "Ready",
+				Status:             metav1.ConditionTrue,
+				ObservedGeneration: cache.Generation,
+				Reason:             "Downloaded",
+			})
+			Expect(k8sClient.Status().Update(ctx, cache)).To(Succeed())
+
+			_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(k8sClient.Get(ctx, deploymentName, deployment)).To(Succeed())
+			Expect(deployment.Spec.Template.Spec.Volumes).To(ContainElement(corev1.Volume{
+				Name: "models",
+				VolumeSource: corev1.VolumeSource{
+					PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: cacheName + "-model-cache", ReadOnly: true},
+				},
+			}))
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			Expect(meta.IsStatusConditionTrue(resource.Status.Conditions, "ModelsReady")).To(BeTrue())
+		})
+	})
+
+	Context("When exposing the resource through an Ingress", func() {
+		const resourceName = "test-ingress"
+
+		ctx := context.Background()
+
+		typeNamespacedName := types.NamespacedName{
+			Name:      resourceName,
+			Namespace: "default",
+		}
+
+		BeforeEach(func() {
+			By("creating the custom resource for the Kind DoclingServe with an ingress")
+			resource := &doclinggithubiov1alpha1.DoclingServe{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      resourceName,
+					Namespace: "default",
+				},
+				Spec: doclinggithubiov1alpha1.DoclingServeSpec{
+					APIServer: &doclinggithubiov1alpha1.APIServer{
+						Image: "registry/image:tag",
+					},
+					Engine: &doclinggithubiov1alpha1.Engine{
+						Local: &doclinggithubiov1alpha1.Local{},
+					},
+					Ingress: &doclinggithubiov1alpha1.Ingress{
+						Enabled:       true,
+						Host:          "docling.example.com",
+						TLSSecretName: "docling-tls",
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, resource)).To(Succeed())
+		})
+
+		AfterEach(func() {
+			resource := &doclinggithubiov1alpha1.DoclingServe{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+
+			By("Cleanup the specific resource instance DoclingServe")
+			Expect(k8sClient.Delete(ctx, resource)).To(Succeed())
+		})
+
+		It("should create and delete the Ingress", func() {
+			controllerReconciler := &DoclingServeReconciler{
+				Client: k8sClient,
+				Scheme: k8sClient.Scheme(),
+			}
+
+			By("Reconciling the created resource")
+			_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+
+			ingress := &networkingv1.Ingress{}
+			ingressName := types.NamespacedName{Name: resourceName + "-ingress", Namespace: "default"}
+			Expect(k8sClient.Get(ctx, ingressName, ingress)).To(Succeed())
+			Expect(ingress.Spec.Rules).To(HaveLen(1))
+			Expect(ingress.Spec.Rules[0].Host).To(Equal("docling.example.com"))
+			Expect(ingress.Spec.Rules[0].HTTP.Paths[0].Backend.Service.Name).To(Equal(resourceName + "-service"))
+			Expect(ingress.Spec.TLS).To(HaveLen(1))
+			Expect(ingress.Spec.TLS[0].SecretName).To(Equal("docling-tls"))
+
+			By("Disabling the ingress")
+			resource := &doclinggithubiov1alpha1.DoclingServe{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			resource.Spec.Ingress.Enabled = false
+			Expect(k8sClient.Update(ctx, resource)).To(Succeed())
+
+			_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			err = k8sClient.Get(ctx, ingressName, ingress)
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+		})
+	})
+
+	Context("When exposing the resource through a Gateway", func() {
+		const resourceName = "test-httproute"
+
+		ctx := context.Background()
+
+		typeNamespacedName := types.NamespacedName{
+			Name:      resourceName,
+			Namespace: "default",
+		}
+
+		BeforeEach(func() {
+			By("creating the custom resource for the Kind DoclingServe with a gateway")
+			resource := &doclinggithubiov1alpha1.DoclingServe{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      resourceName,
+					Namespace: "default",
+				},
+				Spec: doclinggithubiov1alpha1.DoclingServeSpec{
+					APIServer: &doclinggithubiov1alpha1.APIServer{
+						Image: "registry/image:tag",
+					},
+					Engine: &doclinggithubiov1alpha1.Engine{
+						Local: &doclinggithubiov1alpha1.Local{},
+					},
+					Gateway: &doclinggithubiov1alpha1.Gateway{
+						Enabled:     true,
+						Name:        "public",
+						Namespace:   "gateways",
+						SectionName: "https",
+						Hostnames:   []string{"docling.example.com"},
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, resource)).To(Succeed())
+		})
+
+		AfterEach(func() {
+			resource := &doclinggithubiov1alpha1.DoclingServe{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+
+			By("Cleanup the specific resource instance DoclingServe")
+			Expect(k8sClient.Delete(ctx, resource)).To(Succeed())
+		})
+
+		It("should create and delete the HTTPRoute", func() {
+			controllerReconciler := &DoclingServeReconciler{
+				Client:       k8sClient,
+				Scheme:       k8sClient.Scheme(),
+				Capabilities: reconcilers.Capabilities{GatewayAPI: true},
+			}
+
+			By("Reconciling the created resource")
+			_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+
+			httpRoute := &gatewayv1.HTTPRoute{}
+			httpRouteName := types.NamespacedName{Name: resourceName + "-httproute", Namespace: "default"}
+			Expect(k8sClient.Get(ctx, httpRouteName, httpRoute)).To(Succeed())
+			Expect(httpRoute.Spec.ParentRefs).To(ConsistOf(gatewayv1.ParentReference{
+				Group:       ptr.To(gatewayv1.Group(gatewayv1.GroupName)),
+				Kind:        ptr.To(gatewayv1.Kind("Gateway")),
+				Name:        "public",
+				Namespace:   ptr.To(gatewayv1.Namespace("gateways")),
+				SectionName: ptr.To(gatewayv1.SectionName("https")),
+			}))
+			Expect(httpRoute.Spec.Hostnames).To(ConsistOf(gatewayv1.Hostname("docling.example.com")))
+			Expect(httpRoute.Spec.Rules).To(HaveLen(1))
+			Expect(httpRoute.Spec.Rules[0].BackendRefs).To(HaveLen(1))
+			backend := httpRoute.Spec.Rules[0].BackendRefs[0].BackendObjectReference
+			Expect(backend.Name).To(Equal(gatewayv1.ObjectName(resourceName + "-service")))
+
+			By("Pointing at the port of the Service")
+			service := &corev1.Service{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: resourceName + "-service", Namespace: "default"}, service)).To(Succeed())
+			Expect(service.Spec.Ports).To(HaveLen(1))
+			Expect(backend.Port).To(Equal(ptr.To(gatewayv1.PortNumber(service.Spec.Ports[0].Port))))
+
+			By("Disabling the gateway")
+			resource := &doclinggithubiov1alpha1.DoclingServe{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			resource.Spec.Gateway.Enabled = false
+			Expect(k8sClient.Update(ctx, resource)).To(Succeed())
+
+			_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			err = k8sClient.Get(ctx, httpRouteName, httpRoute)
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+		})
+	})
+
+	Context("When running the resource with a service account", func() {
+		const resourceName = "test-serviceaccount"
+
+		ctx := context.Background()
+
+		typeNamespacedName := types.NamespacedName{
+			Name:      resourceName,
+			Namespace: "default",
+		}
+
+		BeforeEach(func() {
+			By("creating the custom resource for the Kind DoclingServe")
+			resource := &doclinggithubiov1alpha1.DoclingServe{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      resourceName,
+					Namespace: "default",
+				},
+				Spec: doclinggithubiov1alpha1.DoclingServeSpec{
+					APIServer: &doclinggithubiov1alpha1.APIServer{
+						Image: "registry/image:tag",
+					},
+					Engine: &doclinggithubiov1alpha1.Engine{
+						Local: &doclinggithubiov1alpha1.Local{},
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, resource)).To(Succeed())
+		})
+
+		AfterEach(func() {
+			resource := &doclinggithubiov1alpha1.DoclingServe{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+
+			By("Cleanup the specific resource instance DoclingServe")
+			Expect(k8sClient.Delete(ctx, resource)).To(Succeed())
+		})
+
+		It("should run with its own service account unless one is referenced", func() {
+			controllerReconciler := &DoclingServeReconciler{
+				Client: k8sClient,
+				Scheme: k8sClient.Scheme(),
+			}
+
+			By("Reconciling the created resource")
+			_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+
+			resource := &doclinggithubiov1alpha1.DoclingServe{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			serviceAccount := &corev1.ServiceAccount{}
+			serviceAccountName := types.NamespacedName{Name: resourceName + "-sa", Namespace: "default"}
+			Expect(k8sClient.Get(ctx, serviceAccountName, serviceAccount)).To(Succeed())
+			Expect(metav1.IsControlledBy(serviceAccount, resource)).To(BeTrue())
+
+			deployment := &appsv1.Deployment{}
+			deploymentName := types.NamespacedName{Name: resourceName + "-deployment", Namespace: "default"}
+			Expect(k8sClient.Get(ctx, deploymentName, deployment)).To(Succeed())
+			Expect(deployment.Spec.Template.Spec.ServiceAccountName).To(Equal(resourceName + "-sa"))
+
+			By("Referencing an existing service account")
+			existing := &corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Name: "docling-existing", Namespace: "default"}}
+			Expect(k8sClient.Create(ctx, existing)).To(Succeed())
+			DeferCleanup(k8sClient.Delete, ctx, existing)
+			resource.Spec.APIServer.ServiceAccountName = "docling-existing"
+			Expect(k8sClient.Update(ctx, resource)).To(Succeed())
+
+			_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(k8sClient.Get(ctx, deploymentName, deployment)).To(Succeed())
+			Expect(deployment.Spec.Template.Spec.ServiceAccountName).To(Equal("docling-existing"))
+			err = k8sClient.Get(ctx, serviceAccountName, serviceAccount)
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: "docling-existing", Namespace: "default"}, existing)).To(Succeed())
+			Expect(existing.OwnerReferences).To(BeEmpty())
+		})
+
+		It("should migrate off the shared service account", func() {
+			controllerReconciler := &DoclingServeReconciler{
+				Client: k8sClient,
+				Scheme: k8sClient.Scheme(),
+			}
+
+			By("Creating the shared service account of earlier versions")
+			resource := &doclinggithubiov1alpha1.DoclingServe{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			legacy := &corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{
+				Name:      "docling-serve",
+				Namespace: "default",
+				Labels:    map[string]string{"app": "docling-serve", "doclingserve_cr": resourceName},
+			}}
+			Expect(controllerutil.SetControllerReference(resource, legacy, k8sClient.Scheme())).To(Succeed())
+			Expect(k8sClient.Create(ctx, legacy)).To(Succeed())
+
+			By("Reconciling while the deployment rolls out")
+			_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+
+			legacyName := types.NamespacedName{Name: "docling-serve", Namespace: "default"}
+			Expect(k8sClient.Get(ctx, legacyName, legacy)).To(Succeed())
+			Expect(legacy.OwnerReferences).To(BeEmpty())
+
+			By("Rolling out the deployment like the deployment controller would")
+			deployment := &appsv1.Deployment{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: resourceName + "-deployment", Namespace: "default"}, deployment)).To(Succeed())
+			deployment.Status = appsv1.DeploymentStatus{
+				ObservedGeneration: deployment.Generation,
+				Replicas:           1,
+				UpdatedReplicas:    1,
+				ReadyReplicas:      1,
+				AvailableReplicas:  1,
+			}
+			Expect(k8sClient.Status().Update(ctx, deployment)).To(Succeed())
+
+			_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			err = k8sClient.Get(ctx, legacyName, legacy)
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+		})
+		It("should release the shared service account labelled with another resource", func() {
+			controllerReconciler := &DoclingServeReconciler{
+				Client: k8sClient,
+				Scheme: k8sClient.Scheme(),
+			}
+
+			By("Creating the shared service account owned by the first resource and labelled by the last one")
+			resource := &doclinggithubiov1alpha1.DoclingServe{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			legacy := &corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{
+				Name:      "docling-serve",
+				Namespace: "default",
+				Labels:    map[string]string{"app": "docling-serve", "doclingserve_cr": "test-serviceaccount-other"},
+			}}
+			Expect(controllerutil.SetControllerReference(resource, legacy, k8sClient.Scheme())).To(Succeed())
+			Expect(k8sClient.Create(ctx, legacy)).To(Succeed())
+			DeferCleanup(k8sClient.Delete, ctx, legacy)
+
+			By("Running the other resource with the shared service account")
+			labels := map[string]string{"app": "docling-serve", "doclingserve_cr": "test-serviceaccount-other"}
+			other := &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-serviceaccount-other-deployment", Namespace: "default", Labels: labels},
+				Spec: appsv1.DeploymentSpec{
+					Selector: &metav1.LabelSelector{MatchLabels: labels},
+					Template: corev1.PodTemplateSpec{
+						ObjectMeta: metav1.ObjectMeta{Labels: labels},
+						Spec: corev1.PodSpec{
+							ServiceAccountName: "docling-serve",
+							Containers:         []corev1.Container{{Name: "docling-serve", Image: "registry/image:tag"}},
+						},
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, other)).To(Succeed())
+			DeferCleanup(k8sClient.Delete, ctx, other)
+
+			By("Reconciling the owner")
+			_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: "docling-serve", Namespace: "default"}, legacy)).To(Succeed())
+			Expect(legacy.OwnerReferences).To(BeEmpty())
+		})
+	})
+
+	Context("When protecting the resource with an API key", func() {
+		const resourceName = "test-authentication"
+
+		ctx := context.Background()
+
+		typeNamespacedName := types.NamespacedName{
+			Name:      resourceName,
+			Namespace: "default",
+		}
+
+		BeforeEach(func() {
+			By("creating the custom resource for the Kind DoclingServe with authentication")
+			resource := &doclinggithubiov1alpha1.DoclingServe{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      resourceName,
+					Namespace: "default",
+				},
+				Spec: doclinggithubiov1alpha1.DoclingServeSpec{
+					APIServer: &doclinggithubiov1alpha1.APIServer{
+						Image: "registry/image:tag",
+						Authentication: &doclinggithubiov1alpha1.Authentication{
+							Enabled:          true,
+							RotationInterval: &metav1.Duration{Duration: 24 * time.Hour},
+						},
+					},
+					Engine: &doclinggithubiov1alpha1.Engine{
+						Local: &doclinggithubiov1alpha1.Local{},
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, resource)).To(Succeed())
+		})
+
+		AfterEach(func() {
+			resource := &doclinggithubiov1alpha1.DoclingServe{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+
+			By("Cleanup the specific resource instance DoclingServe")
+			Expect(k8sClient.Delete(ctx, resource)).To(Succeed())
+		})
+
+		It("should generate, inject and rotate the API key", func() {
+			controllerReconciler := &DoclingServeReconciler{
+				Client: k8sClient,
+				Scheme: k8sClient.Scheme(),
+			}
+
+			By("Reconciling the created resource")
+			result, err := controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.RequeueAfter).To(BeNumerically("~", 24*time.Hour, time.Minute))
+
+			secret := &corev1.Secret{}
+			secretName := types.NamespacedName{Name: resourceName + "-api-key", Namespace: "default"}
+			Expect(k8sClient.Get(ctx, secretName, secret)).To(Succeed())
+			apiKey := string(secret.Data["api-key"])
+			Expect(apiKey).NotTo(BeEmpty())
+
+			deployment := &appsv1.Deployment{}
+			deploymentName := types.NamespacedName{Name: resourceName + "-deployment", Namespace: "default"}
+			Expect(k8sClient.Get(ctx, deploymentName, deployment)).To(Succeed())
+			Expect(deployment.Spec.Template.Spec.Containers[0].Env).To(ContainElement(corev1.EnvVar{
+				Name: "DOCLING_SERVE_API_KEY",
+				ValueFrom: &corev1.EnvVarSource{SecretKeyRef: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: resourceName + "-api-key"},
+					Key:                  "api-key",
+				}},
+			}))
+
+			resource := &doclinggithubiov1alpha1.DoclingServe{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			Expect(resource.Status.APIKeySecretName).To(Equal(resourceName + "-api-key"))
+
+			By("Reaching the rotation interval")
+			expired := time.Now().Add(-25 * time.Hour).UTC().Format(time.RFC3339)
+			secret.Annotations["docling.github.io/api-key-rotated-at"] = expired
+			Expect(k8sClient.Update(ctx, secret)).To(Succeed())
+
+			_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(k8sClient.Get(ctx, secretName, secret)).To(Succeed())
+			Expect(string(secret.Data["api-key"])).NotTo(Equal(apiKey))
+			rotatedAt := secret.Annotations["docling.github.io/api-key-rotated-at"]
+			Expect(rotatedAt).NotTo(Equal(expired))
+			Expect(k8sClient.Get(ctx, deploymentName, deployment)).To(Succeed())
+			Expect(deployment.Spec.Template.Annotations).To(HaveKeyWithValue("docling.github.io/api-key-rotated-at", rotatedAt))
+		})
+	})
+
+	Context("When exposing the resource through a Route with a custom certificate", func() {
+		const resourceName = "test-route-tls"
+
+		ctx := context.Background()
+
+		typeNamespacedName := types.NamespacedName{
+			Name:      resourceName,
+			Namespace: "default",
+		}
+		secretName := types.NamespacedName{
+			Name:      resourceName + "-certs",
+			Namespace: "default",
+		}
+
+		BeforeEach(func() {
+			By("creating the certificate Secret")
+			secret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      secretName.Name,
+					Namespace: secretName.Namespace,
+				},
+				Data: map[string][]byte{
+					"tls.crt":            []byte("certificate"),
+					"tls.key":            []byte("key"),
+					"ca.crt":             []byte("ca"),
+					"destination-ca.crt": []byte("destination-ca"),
+				},
+			}
+			Expect(k8sClient.Create(ctx, secret)).To(Succeed())
+
+			By("creating the custom resource for the Kind DoclingServe with a reencrypt route")
+			resource := &doclinggithubiov1alpha1.DoclingServe{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      resourceName,
+					Namespace: "default",
+				},
+				Spec: doclinggithubiov1alpha1.DoclingServeSpec{
+					APIServer: &doclinggithubiov1alpha1.APIServer{
+						Image: "registry/image:tag",
+					},
+					Engine: &doclinggithubiov1alpha1.Engine{
+						Local: &doclinggithubiov1alpha1.Local{},
+					},
+					Route: &doclinggithubiov1alpha1.Route{
+						Enabled:       true,
+						Host:          "docling.apps.example.com",
+						Termination:   "reencrypt",
+						TLSSecretName: secretName.Name,
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, resource)).To(Succeed())
+		})
+
+		AfterEach(func() {
+			resource := &doclinggithubiov1alpha1.DoclingServe{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+
+			By("Cleanup the specific resource instance DoclingServe")
+			Expect(k8sClient.Delete(ctx, resource)).To(Succeed())
+
+			secret := &corev1.Secret{}
+			Expect(k8sClient.Get(ctx, secretName, secret)).To(Succeed())
+			Expect(k8sClient.Delete(ctx, secret)).To(Succeed())
+		})
+
+		It("should copy the certificate into the Route", func() {
+			controllerReconciler := &DoclingServeReconciler{
+				Client:       k8sClient,
+				Scheme:       k8sClient.Scheme(),
+				Capabilities: reconcilers.Capabilities{Route: true},
+			}
+
+			By("Reconciling the created resource")
+			_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+
+			route := &routev1.Route{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: resourceName + "-route", Namespace: "default"}, route)).To(Succeed())
+			Expect(route.Spec.Host).To(Equal("docling.apps.example.com"))
+			Expect(route.Spec.TLS.Termination).To(Equal(routev1.TLSTerminationReencrypt))
+			Expect(route.Spec.TLS.Certificate).To(Equal("certificate"))
+			Expect(route.Spec.TLS.Key).To(Equal("key"))
+			Expect(route.Spec.TLS.CACertificate).To(Equal("ca"))
+			Expect(route.Spec.TLS.DestinationCACertificate).To(Equal("destination-ca"))
+			Expect(route.Annotations).To(HaveKeyWithValue("haproxy.router.openshift.io/timeout", "130s"))
+		})
+
+		It("should keep the router timeout of the annotations", func() {
+			controllerReconciler := &DoclingServeReconciler{
+				Client:       k8sClient,
+				Scheme:       k8sClient.Scheme(),
+				Capabilities: reconcilers.Capabilities{Route: true},
+			}
+
+			By("Setting the timeout annotation on the route")
+			resource := &doclinggithubiov1alpha1.DoclingServe{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			resource.Spec.Route.Annotations = map[string]string{"haproxy.router.openshift.io/timeout": "1h"}
+			Expect(k8sClient.Update(ctx, resource)).To(Succeed())
+
+			_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+
+			route := &routev1.Route{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: resourceName + "-route", Namespace: "default"}, route)).To(Succeed())
+			Expect(route.Annotations).To(HaveKeyWithValue("haproxy.router.openshift.io/timeout", "1h"))
+		})
+
+		It("should follow the max sync wait set in the environment", func() {
+			controllerReconciler := &DoclingServeReconciler{
+				Client:       k8sClient,
+				Scheme:       k8sClient.Scheme(),
+				Capabilities: reconcilers.Capabilities{Route: true},
+			}
+
+			By("Setting DOCLING_SERVE_MAX_SYNC_WAIT in the env")
+			resource := &doclinggithubiov1alpha1.DoclingServe{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			resource.Spec.APIServer.Env = []corev1.EnvVar{{Name: "DOCLING_SERVE_MAX_SYNC_WAIT", Value: "600"}}
+			Expect(k8sClient.Update(ctx, resource)).To(Succeed())
+
+			_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+
+			route := &routev1.Route{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: resourceName + "-route", Namespace: "default"}, route)).To(Succeed())
+			Expect(route.Annotations).To(HaveKeyWithValue("haproxy.router.openshift.io/timeout", "610s"))
+		})
+	})
+
+	Context("When protecting the resource with the OAuth proxy", func() {
+		const resourceName = "test-oauth-proxy"
+
+		ctx := context.Background()
+
+		typeNamespacedName := types.NamespacedName{
+			Name:      resourceName,
+			Namespace: "default",
+		}
+
+		BeforeEach(func() {
+			By("creating the custom resource for the Kind DoclingServe with the OAuth proxy")
+			resource := &doclinggithubiov1alpha1.DoclingServe{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      resourceName,
+					Namespace: "default",
+				},
+				Spec: doclinggithubiov1alpha1.DoclingServeSpec{
+					APIServer: &doclinggithubiov1alpha1.APIServer{
+						Image:      "registry/image:tag",
+						EnableUI:   true,
+						OAuthProxy: &doclinggithubiov1alpha1.OAuthProxy{Enabled: true},
+					},
+					Engine: &doclinggithubiov1alpha1.Engine{
+						Local: &doclinggithubiov1alpha1.Local{},
+					},
+					Route: &doclinggithubiov1alpha1.Route{
+						Enabled: true,
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, resource)).To(Succeed())
+		})
+
+		AfterEach(func() {
+			resource := &doclinggithubiov1alpha1.DoclingServe{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+
+			By("Cleanup the specific resource instance DoclingServe")
+			Expect(k8sClient.Delete(ctx, resource)).To(Succeed())
+		})
+
+		It("should only expose docling-serve through the proxy", func() {
+			controllerReconciler := &DoclingServeReconciler{
+				Client:       k8sClient,
+				Scheme:       k8sClient.Scheme(),
+				Capabilities: reconcilers.Capabilities{Route: true},
+			}
+
+			By("Reconciling the created resource")
+			_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+
+			deployment := &appsv1.Deployment{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: resourceName + "-deployment", Namespace: "default"}, deployment)).To(Succeed())
+			Expect(deployment.Spec.Template.Spec.Containers).To(HaveLen(2))
+			proxy := deployment.Spec.Template.Spec.Containers[1]
+			Expect(proxy.Image).To(Equal(doclinggithubiov1alpha1.DefaultOAuthProxyImage))
+			Expect(proxy.Args).To(ContainElement("--openshift-service-account=" + resourceName + "-sa"))
+			Expect(proxy.Args).To(ContainElement(ContainSubstring(`"resource":"doclingserves"`)))
+
+			service := &corev1.Service{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: resourceName + "-service", Namespace: "default"}, service)).To(Succeed())
+			Expect(service.Annotations).To(HaveKeyWithValue("service.beta.openshift.io/serving-cert-secret-name", resourceName+"-proxy-tls"))
+			Expect(service.Spec.Ports).To(HaveLen(1))
+			Expect(service.Spec.Ports[0].Port).To(Equal(int32(8443)))
+
+			serviceAccount := &corev1.ServiceAccount{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: resourceName + "-sa", Namespace: "default"}, serviceAccount)).To(Succeed())
+			Expect(serviceAccount.Annotations).To(HaveKey("serviceaccounts.openshift.io/oauth-redirectreference.primary"))
+
+			route := &routev1.Route{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: resourceName + "-route", Namespace: "default"}, route)).To(Succeed())
+			Expect(route.Spec.TLS.Termination).To(Equal(routev1.TLSTerminationReencrypt))
+			Expect(route.Spec.Port.TargetPort.StrVal).To(Equal("https"))
+
+			secret := &corev1.Secret{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: resourceName + "-oauth-proxy", Namespace: "default"}, secret)).To(Succeed())
+			Expect(secret.Data["session_secret"]).To(HaveLen(32))
+
+			By("Disabling the OAuth proxy")
+			resource := &doclinggithubiov1alpha1.DoclingServe{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			resource.Spec.APIServer.OAuthProxy.Enabled = false
+			Expect(k8sClient.Update(ctx, resource)).To(Succeed())
+
+			_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: resourceName + "-service", Namespace: "default"}, service)).To(Succeed())
+			Expect(service.Annotations).NotTo(HaveKey("service.beta.openshift.io/serving-cert-secret-name"))
+			Expect(service.Spec.Ports[0].Port).To(Equal(int32(5001)))
+			err = k8sClient.Get(ctx, types.NamespacedName{Name: resourceName + "-oauth-proxy", Namespace: "default"}, secret)
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+		})
+	})
+
+	Context("When running the resource with the RQ engine", func() {
+		const resourceName = "test-rq"
+
+		ctx := context.Background()
+
+		typeNamespacedName := types.NamespacedName{
+			Name:      resourceName,
+			Namespace: "default",
+		}
+
+		BeforeEach(func() {
+			By("creating the custom resource for the Kind DoclingServe with a managed Redis")
+			resource := &doclinggithubiov1alpha1.DoclingServe{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      resourceName,
+					Namespace: "default",
+				},
+				Spec: doclinggithubiov1alpha1.DoclingServeSpec{
+					APIServer: &doclinggithubiov1alpha1.APIServer{
+						Image: "registry/image:tag",
+					},
+					Engine: &doclinggithubiov1alpha1.Engine{
+						RQ: &doclinggithubiov1alpha1.RQ{
+							Workers: 3,
+							Redis: doclinggithubiov1alpha1.Redis{
+								Managed: &doclinggithubiov1alpha1.ManagedRedis{Image: "registry/redis:tag"},
+							},
+						},
+					},
+					Worker: &doclinggithubiov1alpha1.Worker{
+						Resources: &corev1.ResourceRequirements{
+							Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("4")},
+						},
+						NodeSelector: map[string]string{"docling.github.io/workers": "true"},
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, resource)).To(Succeed())
+		})
+
+		AfterEach(func() {
+			resource := &doclinggithubiov1alpha1.DoclingServe{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+
+			By("Cleanup the specific resource instance DoclingServe")
+			Expect(k8sClient.Delete(ctx, resource)).To(Succeed())
+		})
+
+		It("should run the workers against the Redis server", func() {
+			controllerReconciler := &DoclingServeReconciler{
+				Client: k8sClient,
+				Scheme: k8sClient.Scheme(),
+			}
+
+			By("Reconciling the created resource")
+			_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+
+			secret := &corev1.Secret{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: resourceName + "-redis", Namespace: "default"}, secret)).To(Succeed())
+			Expect(secret.Data["password"]).NotTo(BeEmpty())
+
+			service := &corev1.Service{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: resourceName + "-redis", Namespace: "default"}, service)).To(Succeed())
+			Expect(service.Spec.Ports[0].Port).To(Equal(int32(6379)))
+
+			statefulSet := &appsv1.StatefulSet{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: resourceName + "-redis", Namespace: "default"}, statefulSet)).To(Succeed())
+			Expect(statefulSet.Spec.Template.Spec.Containers[0].Image).To(Equal("registry/redis:tag"))
+
+			redisURL := corev1.EnvVar{
+				Name:  "DOCLING_SERVE_ENG_RQ_REDIS_URL",
+				Value: "redis://:$(REDIS_PASSWORD)@" + resourceName + "-redis:6379/0",
+			}
+			deployment := &appsv1.Deployment{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: resourceName + "-deployment", Namespace: "default"}, deployment)).To(Succeed())
+			Expect(deployment.Spec.Template.Spec.Containers[0].Env).To(ContainElements(
+				corev1.EnvVar{Name: "DOCLING_SERVE_ENG_KIND", Value: "rq"},
+				redisURL,
+			))
+
+			worker := &appsv1.Deployment{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: resourceName + "-worker", Namespace: "default"}, worker)).To(Succeed())
+			Expect(*worker.Spec.Replicas).To(Equal(int32(3)))
+			container := worker.Spec.Template.Spec.Containers[0]
+			Expect(container.Image).To(Equal("registry/image:tag"))
+			Expect(container.Command).To(Equal([]string{"docling-serve", "rq-worker"}))
+			Expect(container.Env).To(ContainElement(redisURL))
+			Expect(container.Resources.Requests.Cpu().String()).To(Equal("4"))
+			Expect(worker.Spec.Template.Spec.NodeSelector).To(HaveKeyWithValue("docling.github.io/workers", "true"))
+			Expect(deployment.Spec.Template.Spec.NodeSelector).To(BeEmpty())
+
+			By("Switching to the local engine")
+			resource := &doclinggithubiov1alpha1.DoclingServe{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			Expect(meta.FindStatusCondition(resource.Status.Conditions, "RedisReady")).NotTo(BeNil())
+			Expect(meta.IsStatusConditionTrue(resource.Status.Conditions, "WorkerDeploymentCreated")).To(BeTrue())
+			resource.Spec.Engine = &doclinggithubiov1alpha1.Engine{Local: &doclinggithubiov1alpha1.Local{}}
+			resource.Spec.Worker = nil
+			Expect(k8sClient.Update(ctx, resource)).To(Succeed())
+
+			_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+
+			err = k8sClient.Get(ctx, types.NamespacedName{Name: resourceName + "-worker", Namespace: "default"}, worker)
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+			err = k8sClient.Get(ctx, types.NamespacedName{Name: resourceName + "-redis", Namespace: "default"}, statefulSet)
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+			err = k8sClient.Get(ctx, types.NamespacedName{Name: resourceName + "-redis", Namespace: "default"}, service)
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			Expect(meta.FindStatusCondition(resource.Status.Conditions, "WorkerDeploymentCreated")).To(BeNil())
+		})
+	})
+
+	Context("When serving the resource over TLS", func() {
+		const resourceName = "test-tls"
+
+		ctx := context.Background()
+
+		typeNamespacedName := types.NamespacedName{
+			Name:      resourceName,
+			Namespace: "default",
+		}
+
+		BeforeEach(func() {
+			By("creating the custom resource for the Kind DoclingServe with TLS")
+			resource := &doclinggithubiov1alpha1.DoclingServe{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      resourceName,
+					Namespace: "default",
+				},
+				Spec: doclinggithubiov1alpha1.DoclingServeSpec{
+					APIServer: &doclinggithubiov1alpha1.APIServer{
+						Image: "registry/image:tag",
+						TLS:   &doclinggithubiov1alpha1.TLS{Enabled: true},
+					},
+					Engine: &doclinggithubiov1alpha1.Engine{
+						Local: &doclinggithubiov1alpha1.Local{},
+					},
+					Route: &doclinggithubiov1alpha1.Route{
+						Enabled: true,
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, resource)).To(Succeed())
+		})
+
+		AfterEach(func() {
+			resource := &doclinggithubiov1alpha1.DoclingServe{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+
+			By("Cleanup the specific resource instance DoclingServe")
+			Expect(k8sClient.Delete(ctx, resource)).To(Succeed())
+		})
+
+		It("should serve HTTPS with a certificate of the service CA", func() {
+			controllerReconciler := &DoclingServeReconciler{
+				Client:       k8sClient,
+				Scheme:       k8sClient.Scheme(),
+				Capabilities: reconcilers.Capabilities{Route: true},
+			}
+
+			By("Reconciling the created resource")
+			_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+
+			deployment := &appsv1.Deployment{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: resourceName + "-deployment", Namespace: "default"}, deployment)).To(Succeed())
+			container := deployment.Spec.Template.Spec.Containers[0]
+			Expect(container.Env).To(ContainElement(corev1.EnvVar{Name: "UVICORN_SSL_CERTFILE", Value: "/etc/docling-serve/tls/tls.crt"}))
+			Expect(container.Env).To(ContainElement(corev1.EnvVar{Name: "UVICORN_SSL_KEYFILE", Value: "/etc/docling-serve/tls/tls.key"}))
+			Expect(container.ReadinessProbe.HTTPGet.Scheme).To(Equal(corev1.URISchemeHTTPS))
+			Expect(container.LivenessProbe.HTTPGet.Scheme).To(Equal(corev1.URISchemeHTTPS))
+			Expect(deployment.Spec.Template.Spec.Volumes).To(ContainElement(HaveField("Secret.SecretName", resourceName+"-tls")))
+
+			service := &corev1.Service{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: resourceName + "-service", Namespace: "default"}, service)).To(Succeed())
+			Expect(service.Annotations).To(HaveKeyWithValue("service.beta.openshift.io/serving-cert-secret-name", resourceName+"-tls"))
+			Expect(service.Spec.Ports[0].Name).To(Equal("https"))
+
+			route := &routev1.Route{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: resourceName + "-route", Namespace: "default"}, route)).To(Succeed())
+			Expect(route.Spec.TLS.Termination).To(Equal(routev1.TLSTerminationReencrypt))
+			Expect(route.Spec.Port.TargetPort.StrVal).To(Equal("https"))
+		})
+
+		It("should request the certificate from cert-manager", func() {
+			controllerReconciler := &DoclingServeReconciler{
+				Client:       k8sClient,
+				Scheme:       k8sClient.Scheme(),
+				Capabilities: reconcilers.Capabilities{CertManager: true},
+			}
+
+			By("Switching to the cert-manager provider")
+			resource := &doclinggithubiov1alpha1.DoclingServe{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			resource.Spec.APIServer.TLS.Provider = "CertManager"
+			resource.Spec.APIServer.TLS.CertManager = &doclinggithubiov1alpha1.CertManagerTLS{IssuerName: "docling-ca", IssuerKind: "ClusterIssuer"}
+			Expect(k8sClient.Update(ctx, resource)).To(Succeed())
+
+			_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+
+			certificate := reconcilers.NewCertificate()
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: resourceName + "-certificate", Namespace: "default"}, certificate)).To(Succeed())
+			secretName, _, _ := unstructured.NestedString(certificate.Object, "spec", "secretName")
+			Expect(secretName).To(Equal(resourceName + "-tls"))
+			issuerKind, _, _ := unstructured.NestedString(certificate.Object, "spec", "issuerRef", "kind")
+			Expect(issuerKind).To(Equal("ClusterIssuer"))
+			dnsNames, _, _ := unstructured.NestedStringSlice(certificate.Object, "spec", "dnsNames")
+			Expect(dnsNames).To(ContainElement(resourceName + "-service.default.svc"))
+
+			service := &corev1.Service{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: resourceName + "-service", Namespace: "default"}, service)).To(Succeed())
+			Expect(service.Annotations).NotTo(HaveKey("service.beta.openshift.io/serving-cert-secret-name"))
+
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			Expect(meta.IsStatusConditionTrue(resource.Status.Conditions, "CertificateCreated")).To(BeTrue())
+			Expect(meta.FindStatusCondition(resource.Status.Conditions, "CertificateReady").Status).To(Equal(metav1.ConditionUnknown))
+		})
+	})
+
+	Context("When isolating the resource with a NetworkPolicy", func() {
+		const resourceName = "test-networkpolicy"
+
+		ctx := context.Background()
+
+		typeNamespacedName := types.NamespacedName{
+			Name:      resourceName,
+			Namespace: "default",
+		}
+
+		BeforeEach(func() {
+			By("creating the custom resource for the Kind DoclingServe with a network policy")
+			resource := &doclinggithubiov1alpha1.DoclingServe{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      resourceName,
+					Namespace: "default",
+				},
+				Spec: doclinggithubiov1alpha1.DoclingServeSpec{
+					APIServer: &doclinggithubiov1alpha1.APIServer{
+						Image: "registry/image:tag",
+					},
+					Engine: &doclinggithubiov1alpha1.Engine{
+						KFP: &doclinggithubiov1alpha1.KFP{Endpoint: "https://ds-pipeline.kfp.svc.cluster.local:8888"},
+					},
+					Route: &doclinggithubiov1alpha1.Route{
+						Enabled: true,
+					},
+					NetworkPolicy: &doclinggithubiov1alpha1.NetworkPolicy{
+						Mode: "allowFrom",
+						From: []networkingv1.NetworkPolicyPeer{{
+							NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"team": "documents"}},
+						}},
+						RestrictEgress: true,
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, resource)).To(Succeed())
+		})
+
+		AfterEach(func() {
+			resource := &doclinggithubiov1alpha1.DoclingServe{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+
+			By("Cleanup the specific resource instance DoclingServe")
+			Expect(k8sClient.Delete(ctx, resource)).To(Succeed())
+		})
+
+		It("should create and delete the NetworkPolicy", func() {
+			controllerReconciler := &DoclingServeReconciler{
+				Client:       k8sClient,
+				Scheme:       k8sClient.Scheme(),
+				Capabilities: reconcilers.Capabilities{Route: true},
+			}
+
+			By("Reconciling the created resource")
+			_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+
+			networkPolicy := &networkingv1.NetworkPolicy{}
+			networkPolicyName := types.NamespacedName{Name: resourceName + "-networkpolicy", Namespace: "default"}
+			Expect(k8sClient.Get(ctx, networkPolicyName, networkPolicy)).To(Succeed())
+			Expect(networkPolicy.Spec.PolicyTypes).To(ConsistOf(networkingv1.PolicyTypeIngress, networkingv1.PolicyTypeEgress))
+			Expect(networkPolicy.Spec.Ingress).To(HaveLen(1))
+			Expect(networkPolicy.Spec.Ingress[0].From).To(HaveLen(3))
+			Expect(networkPolicy.Spec.Ingress[0].From[1].NamespaceSelector.MatchLabels).To(HaveKey("policy-group.network.openshift.io/ingress"))
+			Expect(networkPolicy.Spec.Egress).To(HaveLen(2))
+			kfp := networkPolicy.Spec.Egress[1]
+			Expect(kfp.Ports[0].Port.IntValue()).To(Equal(8888))
+			Expect(kfp.To[0].NamespaceSelector.MatchLabels).To(HaveKeyWithValue("kubernetes.io/metadata.name", "kfp"))
+
+			By("Running the conversions in RQ workers")
+			resource := &doclinggithubiov1alpha1.DoclingServe{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			resource.Spec.Engine = &doclinggithubiov1alpha1.Engine{RQ: &doclinggithubiov1alpha1.RQ{
+				Workers: 1,
+				Redis:   doclinggithubiov1alpha1.Redis{Managed: &doclinggithubiov1alpha1.ManagedRedis{Image: "registry/redis:tag"}},
+			}}
+			Expect(k8sClient.Update(ctx, resource)).To(Succeed())
+
+			_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(k8sClient.Get(ctx, networkPolicyName, networkPolicy)).To(Succeed())
+			selector, err := metav1.LabelSelectorAsSelector(&networkPolicy.Spec.PodSelector)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(selector.Matches(labels.Set{"app": "docling-serve-worker", "doclingserve_cr": resourceName})).To(BeTrue())
+			Expect(selector.Matches(labels.Set{"app": "docling-serve", "doclingserve_cr": resourceName})).To(BeTrue())
+			Expect(networkPolicy.Spec.Egress).To(HaveLen(2))
+			redis := networkPolicy.Spec.Egress[1]
+			Expect(redis.Ports[0].Port.IntValue()).To(Equal(6379))
+			Expect(redis.To[0].PodSelector.MatchLabels).To(HaveKeyWithValue("app", "docling-serve-redis"))
+
+			By("Disabling the network policy")
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			resource.Spec.NetworkPolicy = &doclinggithubiov1alpha1.NetworkPolicy{Mode: "none"}
+			Expect(k8sClient.Update(ctx, resource)).To(Succeed())
+
+			_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			err = k8sClient.Get(ctx, networkPolicyName, networkPolicy)
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+		})
+	})
+
+	Context("When autoscaling the resource", func() {
+		const resourceName = "test-autoscaling"
+
+		ctx := context.Background()
+
+		typeNamespacedName := types.NamespacedName{
+			Name:      resourceName,
+			Namespace: "default",
+		}
+
+		BeforeEach(func() {
+			By("creating the custom resource for the Kind DoclingServe with autoscaling")
+			resource := &doclinggithubiov1alpha1.DoclingServe{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      resourceName,
+					Namespace: "default",
+				},
+				Spec: doclinggithubiov1alpha1.DoclingServeSpec{
+					APIServer: &doclinggithubiov1alpha1.APIServer{
+						Image:     "registry/image:tag",
+						Instances: 1,
+						Autoscaling: &doclinggithubiov1alpha1.Autoscaling{
+							Enabled:     true,
+							MinReplicas: ptr.To(int32(2)),
+							MaxReplicas: 5,
+						},
+					},
+					Engine: &doclinggithubiov1alpha1.Engine{
+						Local: &doclinggithubiov1alpha1.Local{},
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, resource)).To(Succeed())
+		})
+
+		AfterEach(func() {
+			resource := &doclinggithubiov1alpha1.DoclingServe{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+
+			By("Cleanup the specific resource instance DoclingServe")
+			Expect(k8sClient.Delete(ctx, resource)).To(Succeed())
+		})
+
+		It("should create the HorizontalPodAutoscaler and leave the replicas to it", func() {
+			controllerReconciler := &DoclingServeReconciler{
+				Client: k8sClient,
+				Scheme: k8sClient.Scheme(),
+			}
+
+			By("Reconciling the created resource")
+			_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+
+			hpa := &autoscalingv2.HorizontalPodAutoscaler{}
+			hpaName := types.NamespacedName{Name: resourceName + "-hpa", Namespace: "default"}
+			Expect(k8sClient.Get(ctx, hpaName, hpa)).To(Succeed())
+			Expect(hpa.Spec.ScaleTargetRef.Name).To(Equal(resourceName + "-deployment"))
+			Expect(hpa.Spec.MinReplicas).To(Equal(ptr.To(int32(2))))
+			Expect(hpa.Spec.MaxReplicas).To(Equal(int32(5)))
+			Expect(hpa.Spec.Metrics).To(HaveLen(1))
+			Expect(hpa.Spec.Metrics[0].Resource.Name).To(Equal(corev1.ResourceCPU))
+
+			deployment := &appsv1.Deployment{}
+			deploymentName := types.NamespacedName{Name: resourceName + "-deployment", Namespace: "default"}
+			Expect(k8sClient.Get(ctx, deploymentName, deployment)).To(Succeed())
+			Expect(deployment.Spec.Replicas).To(Equal(ptr.To(int32(2))))
+
+			By("Scaling the Deployment like the autoscaler would")
+			deployment.Spec.Replicas = ptr.To(int32(4))
+			Expect(k8sClient.Update(ctx, deployment)).To(Succeed())
+
+			_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(k8sClient.Get(ctx, deploymentName, deployment)).To(Succeed())
+			Expect(deployment.Spec.Replicas).To(Equal(ptr.To(int32(4))))
+
+			resource := &doclinggithubiov1alpha1.DoclingServe{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			Expect(resource.Status.Selector).To(Equal("app=docling-serve,doclingserve_cr=" + resourceName))
+
+			By("Disabling autoscaling")
+			resource.Spec.APIServer.Autoscaling.Enabled = false
+			Expect(k8sClient.Update(ctx, resource)).To(Succeed())
+
+			_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+			err = k8sClient.Get(ctx, hpaName, hpa)
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+			Expect(k8sClient.Get(ctx, deploymentName, deployment)).To(Succeed())
+			Expect(deployment.Spec.Replicas).To(Equal(ptr.To(int32(1))))
+		})
+	})
+
+	Context("When autoscaling the resource with KEDA", func() {
+		const resourceName = "test-keda"
+
+		ctx := context.Background()
+
+		typeNamespacedName := types.NamespacedName{
+			Name:      resourceName,
+			Namespace: "default",
+		}
+
+		BeforeEach(func() {
+			By("creating the custom resource for the Kind DoclingServe with KEDA autoscaling")
+			resource := &doclinggithubiov1alpha1.DoclingServe{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      resourceName,
+					Namespace: "default",
+				},
+				Spec: doclinggithubiov1alpha1.DoclingServeSpec{
+					APIServer: &doclinggithubiov1alpha1.APIServer{
+						Image: "registry/image:tag",
+						Autoscaling: &doclinggithubiov1alpha1.Autoscaling{
+							Enabled:     true,
+							Mode:        "KEDA",
+							MinReplicas: ptr.To(int32(0)),
+							MaxReplicas: 4,
+							KEDA: &doclinggithubiov1alpha1.KEDAAutoscaling{
+								Prometheus: &doclinggithubiov1alpha1.PrometheusTrigger{
+									ServerAddress: "http://prometheus.monitoring.svc:9090",
+									Query:         "sum(docling_serve_queue_size)",
+									Threshold:     "5",
+								},
+							},
+						},
+					},
+					Engine: &doclinggithubiov1alpha1.Engine{
+						Local: &doclinggithubiov1alpha1.Local{},
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, resource)).To(Succeed())
+		})
+
+		AfterEach(func() {
+			resource := &doclinggithubiov1alpha1.DoclingServe{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+
+			By("Cleanup the specific resource instance DoclingServe")
+			Expect(k8sClient.Delete(ctx, resource)).To(Succeed())
+		})
+
+		It("should create a ScaledObject instead of a HorizontalPodAutoscaler", func() {
+			controllerReconciler := &DoclingServeReconciler{
+				Client:       k8sClient,
+				Scheme:       k8sClient.Scheme(),
+				Capabilities: reconcilers.Capabilities{KEDA: true},
+			}
+
+			By("Reconciling the created resource")
+			_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+
+			scaledObject := reconcilers.NewScaledObject()
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: resourceName + "-scaledobject", Namespace: "default"}, scaledObject)).To(Succeed())
+			Expect(scaledObject.Object["spec"]).To(HaveKeyWithValue("minReplicaCount", BeEquivalentTo(0)))
+			Expect(scaledObject.Object["spec"]).To(HaveKeyWithValue("maxReplicaCount", BeEquivalentTo(4)))
+			triggers, _, _ := unstructured.NestedSlice(scaledObject.Object, "spec", "triggers")
+			Expect(triggers).To(HaveLen(1))
+			Expect(triggers[0]).To(HaveKeyWithValue("type", "prometheus"))
+
+			hpa := &autoscalingv2.HorizontalPodAutoscaler{}
+			err = k8sClient.Get(ctx, types.NamespacedName{Name: resourceName + "-hpa", Namespace: "default"}, hpa)
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+
+			resource := &doclinggithubiov1alpha1.DoclingServe{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			Expect(meta.IsStatusConditionTrue(resource.Status.Conditions, "ScaledObjectCreated")).To(BeTrue())
+		})
+	})
+
+	Context("When summarizing the status of the resource", func() {
+		const resourceName = "test-conditions"
+
+		ctx := context.Background()
+
+		typeNamespacedName := types.NamespacedName{
+			Name:      resourceName,
+			Namespace: "default",
+		}
+
+		BeforeEach(func() {
+			By("creating the custom resource for the Kind DoclingServe")
+			resource := &doclinggithubiov1alpha1.DoclingServe{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      resourceName,
+					Namespace: "default",
+				},
+				Spec: doclinggithubiov1alpha1.DoclingServeSpec{
+					APIServer: &doclinggithubiov1alpha1.APIServer{
+						Image:     "registry/image:tag",
+						Instances: 1,
+					},
+					Engine: &doclinggithubiov1alpha1.Engine{
+						Local: &doclinggithubiov1alpha1.Local{},
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, resource)).To(Succeed())
+		})
+
+		AfterEach(func() {
+			resource := &doclinggithubiov1alpha1.DoclingServe{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+
+			By("Cleanup the specific resource instance DoclingServe")
+			Expect(k8sClient.Delete(ctx, resource)).To(Succeed())
+		})
+
+		It("should report Ready once the deployment is rolled out", func() {
+			controllerReconciler := &DoclingServeReconciler{
+				Client: k8sClient,
+				Scheme: k8sClient.Scheme(),
+			}
+
+			By("Reconciling the created resource")
+			_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+
+			resource := &doclinggithubiov1alpha1.DoclingServe{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			Expect(meta.IsStatusConditionFalse(resource.Status.Conditions, "Ready")).To(BeTrue())
+			Expect(meta.IsStatusConditionTrue(resource.Status.Conditions, "Progressing")).To(BeTrue())
+			Expect(meta.IsStatusConditionFalse(resource.Status.Conditions, "Degraded")).To(BeTrue())
+			readySince := meta.FindStatusCondition(resource.Status.Conditions, "Ready").LastTransitionTime
+			Expect(readySince.IsZero()).To(BeFalse())
+
+			By("Rolling out the deployment like the deployment controller would")
+			deployment := &appsv1.Deployment{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: resourceName + "-deployment", Namespace: "default"}, deployment)).To(Succeed())
+			deployment.Status = appsv1.DeploymentStatus{
+				ObservedGeneration: deployment.Generation,
+				Replicas:           1,
+				UpdatedReplicas:    1,
+				ReadyReplicas:      1,
+				AvailableReplicas:  1,
+				Conditions: []appsv1.DeploymentCondition{{
+					Type:   appsv1.DeploymentAvailable,
+					Status: corev1.ConditionTrue,
+					Reason: "MinimumReplicasAvailable",
+				}},
+			}
+			Expect(k8sClient.Status().Update(ctx, deployment)).To(Succeed())
+
+			_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: typeNamespacedName})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			Expect(meta.IsStatusConditionTrue(resource.Status.Conditions, "Ready")).To(BeTrue())
+			Expect(meta.IsStatusConditionFalse(resource.Status.Conditions, "Progressing")).To(BeTrue())
+			Expect(resource.Status.ReadyReplicas).To(Equal(int32(1)))
+		})
+	})
 })
@@ -0,0 +1,100 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.io/docling-project/docling-operator/api/v1alpha1"
+	"github.io/docling-project/docling-operator/internal/reconcilers"
+)
+
+// secretReferenceIndexKey indexes DoclingServes by the names of the Secrets they reference.
+const secretReferenceIndexKey = "spec.secretReferences"
+
+// configMapReferenceIndexKey indexes DoclingServes by the names of the ConfigMaps they reference.
+const configMapReferenceIndexKey = "spec.configMapReferences"
+
+// modelCacheReferenceIndexKey indexes DoclingServes by the name of the DoclingModelCache they mount.
+const modelCacheReferenceIndexKey = "spec.models.cacheName"
+
+// referencedSecrets returns the names of the Secrets, in the DoclingServe namespace, used by the DoclingServe.
+func referencedSecrets(obj client.Object) []string {
+	doclingServe, ok := obj.(*v1alpha1.DoclingServe)
+	if !ok || doclingServe.Spec.APIServer == nil {
+		return nil
+	}
+
+	names := reconcilers.ReferencedSecrets(doclingServe)
+	if doclingServe.Spec.Route != nil && doclingServe.Spec.Route.TLSSecretName != "" {
+		names = append(names, doclingServe.Spec.Route.TLSSecretName)
+	}
+	return names
+}
+
+// referencedConfigMaps returns the names of the ConfigMaps, in the DoclingServe namespace, used by the DoclingServe.
+func referencedConfigMaps(obj client.Object) []string {
+	doclingServe, ok := obj.(*v1alpha1.DoclingServe)
+	if !ok || doclingServe.Spec.APIServer == nil {
+		return nil
+	}
+
+	return reconcilers.ReferencedConfigMaps(doclingServe)
+}
+
+// referencedModelCache returns the name of the DoclingModelCache, in the DoclingServe namespace, mounted by the DoclingServe.
+func referencedModelCache(obj client.Object) []string {
+	doclingServe, ok := obj.(*v1alpha1.DoclingServe)
+	if !ok || doclingServe.Spec.Models == nil || !doclingServe.Spec.Models.Enabled || doclingServe.Spec.Models.CacheName == "" {
+		return nil
+	}
+
+	return []string{doclingServe.Spec.Models.CacheName}
+}
+
+// doclingServesForModelCache maps a DoclingModelCache to the DoclingServes in its namespace mounting it.
+func (r *DoclingServeReconciler) doclingServesForModelCache(ctx context.Context, cache client.Object) []reconcile.Request {
+	return r.doclingServesForIndex(ctx, modelCacheReferenceIndexKey, cache)
+}
+
+// doclingServesForConfigMap maps a ConfigMap to the DoclingServes in its namespace referencing it.
+func (r *DoclingServeReconciler) doclingServesForConfigMap(ctx context.Context, configMap client.Object) []reconcile.Request {
+	return r.doclingServesForIndex(ctx, configMapReferenceIndexKey, configMap)
+}
+
+// doclingServesForSecret maps a Secret to the DoclingServes in its namespace referencing it.
+func (r *DoclingServeReconciler) doclingServesForSecret(ctx context.Context, secret client.Object) []reconcile.Request {
+	return r.doclingServesForIndex(ctx, secretReferenceIndexKey, secret)
+}
+
+func (r *DoclingServeReconciler) doclingServesForIndex(ctx context.Context, indexKey string, obj client.Object) []reconcile.Request {
+	doclingServes := &v1alpha1.DoclingServeList{}
+	if err := r.List(ctx, doclingServes, client.InNamespace(obj.GetNamespace()), client.MatchingFields{indexKey: obj.GetName()}); err != nil {
+		log.Error(err, "failed to list DoclingServes referencing object", "index", indexKey, "Object.Namespace", obj.GetNamespace(), "Object.Name", obj.GetName())
+		return nil
+	}
+
+	requests := make([]reconcile.Request, 0, len(doclingServes.Items))
+	for _, doclingServe := range doclingServes.Items {
+		requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Name: doclingServe.Name, Namespace: doclingServe.Namespace}})
+	}
+	return requests
+}
@@ -0,0 +1,46 @@
+package reconcilers
+
+import (
+	"sort"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// managedAnnotationsKey records which annotations of an object are owned by the operator.
+const managedAnnotationsKey = "docling.github.io/managed-annotations"
+
+// mergeManagedAnnotations sets the desired annotations on obj and removes the ones the operator set previously
+// but no longer desires, without touching annotations added by users or other controllers.
+func mergeManagedAnnotations(obj metav1.Object, desired map[string]string) {
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+
+	if previous, ok := annotations[managedAnnotationsKey]; ok {
+		for _, key := range strings.Split(previous, ",") {
+			if _, keep := desired[key]; !keep {
+				delete(annotations, key)
+			}
+		}
+	}
+
+	keys := make([]string, 0, len(desired))
+	for key, value := range desired {
+		annotations[key] = value
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	if len(keys) > 0 {
+		annotations[managedAnnotationsKey] = strings.Join(keys, ",")
+	} else {
+		delete(annotations, managedAnnotationsKey)
+	}
+
+	if len(annotations) == 0 {
+		annotations = nil
+	}
+	obj.SetAnnotations(annotations)
+}
@@ -0,0 +1,161 @@
+package reconcilers
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"time"
+
+	"github.io/docling-project/docling-operator/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+const (
+	// apiKeyEnv is the docling-serve setting holding the API key clients must send in the X-Api-Key header.
+	apiKeyEnv = "DOCLING_SERVE_API_KEY"
+	// defaultAPIKeySecretKey is the secret key holding the API key when none is configured.
+	defaultAPIKeySecretKey = "api-key"
+	// apiKeyRotatedAtAnnotation records when the generated API key was last rotated, on the secret and on the pod template.
+	apiKeyRotatedAtAnnotation = "docling.github.io/api-key-rotated-at"
+	// apiKeyLength is the number of random bytes of a generated API key.
+	apiKeyLength = 32
+)
+
+type APIKeySecretReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+
+	requeueAfter time.Duration
+}
+
+func NewAPIKeySecretReconciler(client client.Client, scheme *runtime.Scheme) *APIKeySecretReconciler {
+	return &APIKeySecretReconciler{
+		Client: client,
+		Scheme: scheme,
+	}
+}
+
+func (r *APIKeySecretReconciler) Reconcile(ctx context.Context, doclingServe *v1alpha1.DoclingServe) (bool, error) {
+	if generatesAPIKey(doclingServe) {
+		return r.createOrUpdate(ctx, doclingServe)
+	}
+
+	return r.delete(ctx, doclingServe)
+}
+
+// RequeueAfter returns when the generated API key is due for rotation.
+func (r *APIKeySecretReconciler) RequeueAfter() time.Duration {
+	return r.requeueAfter
+}
+
+func (r *APIKeySecretReconciler) createOrUpdate(ctx context.Context, doclingServe *v1alpha1.DoclingServe) (bool, error) {
+	log := logf.FromContext(ctx)
+	authentication := doclingServe.Spec.APIServer.Authentication
+	key := apiKeySecretKey(doclingServe)
+	secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: doclingServe.Name + "-api-key", Namespace: doclingServe.Namespace}}
+	_, err := controllerutil.CreateOrUpdate(ctx, r.Client, secret, func() error {
+		secret.Labels = labelsForDocling(doclingServe.Name)
+		secret.Type = corev1.SecretTypeOpaque
+
+		now := time.Now()
+		rotatedAt, err := time.Parse(time.RFC3339, secret.Annotations[apiKeyRotatedAtAnnotation])
+		rotate := err != nil || len(secret.Data[key]) == 0
+		if authentication.RotationInterval != nil {
+			rotate = rotate || !now.Before(rotatedAt.Add(authentication.RotationInterval.Duration))
+		}
+		if rotate {
+			apiKey, err := generateAPIKey()
+			if err != nil {
+				return err
+			}
+			secret.Data = map[string][]byte{key: []byte(apiKey)}
+			if secret.Annotations == nil {
+				secret.Annotations = map[string]string{}
+			}
+			rotatedAt = now.UTC().Truncate(time.Second)
+			secret.Annotations[apiKeyRotatedAtAnnotation] = rotatedAt.Format(time.RFC3339)
+		}
+		if authentication.RotationInterval != nil {
+			r.requeueAfter = rotatedAt.Add(authentication.RotationInterval.Duration).Sub(now)
+		}
+
+		_ = ctrl.SetControllerReference(doclingServe, secret, r.Scheme)
+		return nil
+	})
+	if err != nil {
+		log.Error(err, "Error creating API key Secret", "Secret.Namespace", secret.Namespace, "Secret.Name", secret.Name)
+		return true, err
+	}
+
+	log.Info("Successfully created API key Secret", "Secret.Namespace", secret.Namespace, "Secret.Name", secret.Name)
+	return false, nil
+}
+
+func (r *APIKeySecretReconciler) delete(ctx context.Context, doclingServe *v1alpha1.DoclingServe) (bool, error) {
+	log := logf.FromContext(ctx)
+	secret := &corev1.Secret{}
+	err := r.Get(ctx, types.NamespacedName{Name: doclingServe.Name + "-api-key", Namespace: doclingServe.Namespace}, secret)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return false, nil
+		}
+		log.Error(err, "Error getting API key Secret", "Secret.Namespace", doclingServe.Namespace, "Secret.Name", doclingServe.Name+"-api-key")
+		return true, err
+	}
+
+	// A secret with the derived name may have been created by the user and referenced explicitly.
+	if !metav1.IsControlledBy(secret, doclingServe) {
+		return false, nil
+	}
+
+	if err := r.Delete(ctx, secret); err != nil && !errors.IsNotFound(err) {
+		log.Error(err, "Error deleting API key Secret", "Secret.Namespace", secret.Namespace, "Secret.Name", secret.Name)
+		return true, err
+	}
+
+	log.Info("Successfully deleted API key Secret", "Secret.Namespace", secret.Namespace, "Secret.Name", secret.Name)
+	return false, nil
+}
+
+func generateAPIKey() (string, error) {
+	buf := make([]byte, apiKeyLength)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+func authenticationEnabled(doclingServe *v1alpha1.DoclingServe) bool {
+	return doclingServe.Spec.APIServer.Authentication != nil && doclingServe.Spec.APIServer.Authentication.Enabled
+}
+
+// generatesAPIKey reports whether the operator manages the API key secret of the DoclingServe.
+func generatesAPIKey(doclingServe *v1alpha1.DoclingServe) bool {
+	return authenticationEnabled(doclingServe) && doclingServe.Spec.APIServer.Authentication.SecretName == ""
+}
+
+// apiKeySecretName returns the name of the secret holding the API key, or an empty string when authentication is disabled.
+func apiKeySecretName(doclingServe *v1alpha1.DoclingServe) string {
+	if !authenticationEnabled(doclingServe) {
+		return ""
+	}
+	if doclingServe.Spec.APIServer.Authentication.SecretName != "" {
+		return doclingServe.Spec.APIServer.Authentication.SecretName
+	}
+	return doclingServe.Name + "-api-key"
+}
+
+func apiKeySecretKey(doclingServe *v1alpha1.DoclingServe) string {
+	if doclingServe.Spec.APIServer.Authentication.SecretKey != "" {
+		return doclingServe.Spec.APIServer.Authentication.SecretKey
+	}
+	return defaultAPIKeySecretKey
+}
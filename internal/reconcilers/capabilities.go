@@ -0,0 +1,17 @@
+package reconcilers
+
+// Capabilities describes the optional APIs served by the cluster the operator is running on.
+// It is populated once at startup and used to decide which resources can be managed.
+type Capabilities struct {
+	// Route is true when the OpenShift route.openshift.io/v1 Route API is available.
+	Route bool
+	// GatewayAPI is true when the gateway.networking.k8s.io/v1 HTTPRoute API is available.
+	GatewayAPI bool
+	// KEDA is true when the KEDA keda.sh/v1alpha1 ScaledObject API is available.
+	KEDA bool
+	// CertManager is true when the cert-manager cert-manager.io/v1 Certificate API is available.
+	CertManager bool
+	// SecurityContextConstraints is true when the OpenShift security.openshift.io/v1 SecurityContextConstraints API
+	// is available, so the cluster assigns the users of the pods.
+	SecurityContextConstraints bool
+}
@@ -0,0 +1,148 @@
+package reconcilers
+
+import (
+	"context"
+	"fmt"
+
+	"github.io/docling-project/docling-operator/api/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// CertificateGroupVersionKind identifies the cert-manager Certificate. cert-manager is an optional dependency, so
+// Certificates are handled as unstructured objects instead of importing its API module.
+var CertificateGroupVersionKind = schema.GroupVersionKind{Group: "cert-manager.io", Version: "v1", Kind: "Certificate"}
+
+const (
+	tlsProviderServiceCA   = "ServiceCA"
+	tlsProviderCertManager = "CertManager"
+	// tlsMountPath is where the serving certificate is mounted in the docling-serve container.
+	tlsMountPath = "/etc/docling-serve/tls"
+)
+
+type CertificateReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+}
+
+func NewCertificateReconciler(client client.Client, scheme *runtime.Scheme) *CertificateReconciler {
+	return &CertificateReconciler{
+		Client: client,
+		Scheme: scheme,
+	}
+}
+
+// NewCertificate returns an empty unstructured cert-manager Certificate.
+func NewCertificate() *unstructured.Unstructured {
+	certificate := &unstructured.Unstructured{}
+	certificate.SetGroupVersionKind(CertificateGroupVersionKind)
+	return certificate
+}
+
+func (r *CertificateReconciler) Reconcile(ctx context.Context, doclingServe *v1alpha1.DoclingServe) (bool, error) {
+	// The certManager block is required by the CRD validation for the CertManager provider.
+	if tlsProvider(doclingServe) == tlsProviderCertManager && doclingServe.Spec.APIServer.TLS.CertManager != nil {
+		return r.createOrUpdate(ctx, doclingServe)
+	}
+
+	return r.delete(ctx, doclingServe)
+}
+
+func (r *CertificateReconciler) createOrUpdate(ctx context.Context, doclingServe *v1alpha1.DoclingServe) (bool, error) {
+	log := logf.FromContext(ctx)
+	issuer := doclingServe.Spec.APIServer.TLS.CertManager
+	certificate := NewCertificate()
+	certificate.SetName(doclingServe.Name + "-certificate")
+	certificate.SetNamespace(doclingServe.Namespace)
+	_, err := controllerutil.CreateOrUpdate(ctx, r.Client, certificate, func() error {
+		certificate.SetLabels(labelsForDocling(doclingServe.Name))
+
+		issuerRef := map[string]interface{}{
+			"name":  issuer.IssuerName,
+			"kind":  "Issuer",
+			"group": "cert-manager.io",
+		}
+		if issuer.IssuerKind != "" {
+			issuerRef["kind"] = issuer.IssuerKind
+		}
+		if issuer.IssuerGroup != "" {
+			issuerRef["group"] = issuer.IssuerGroup
+		}
+		service := doclingServe.Name + "-service"
+		certificate.Object["spec"] = map[string]interface{}{
+			"secretName": tlsSecretName(doclingServe),
+			"dnsNames": []interface{}{
+				service,
+				fmt.Sprintf("%s.%s", service, doclingServe.Namespace),
+				fmt.Sprintf("%s.%s.svc", service, doclingServe.Namespace),
+				fmt.Sprintf("%s.%s.svc.cluster.local", service, doclingServe.Namespace),
+			},
+			"usages":    []interface{}{"server auth", "digital signature", "key encipherment"},
+			"issuerRef": issuerRef,
+		}
+
+		_ = ctrl.SetControllerReference(doclingServe, certificate, r.Scheme)
+		return nil
+	})
+	if err != nil {
+		log.Error(err, "Error creating/updating Certificate", "Certificate.Namespace", certificate.GetNamespace(), "Certificate.Name", certificate.GetName())
+		return true, err
+	}
+
+	log.Info("Successfully created/updated Certificate", "Certificate.Namespace", certificate.GetNamespace(), "Certificate.Name", certificate.GetName())
+	return false, nil
+}
+
+func (r *CertificateReconciler) delete(ctx context.Context, doclingServe *v1alpha1.DoclingServe) (bool, error) {
+	log := logf.FromContext(ctx)
+	certificate := NewCertificate()
+	if err := r.Get(ctx, types.NamespacedName{Name: doclingServe.Name + "-certificate", Namespace: doclingServe.Namespace}, certificate); err != nil && !errors.IsNotFound(err) {
+		log.Error(err, "Error deleting Certificate", "Certificate.Namespace", doclingServe.Namespace, "Certificate.Name", doclingServe.Name+"-certificate")
+		return true, err
+	} else if errors.IsNotFound(err) {
+		return false, nil
+	}
+
+	if err := r.Delete(ctx, certificate); err != nil {
+		log.Error(err, "Error deleting Certificate", "Certificate.Namespace", certificate.GetNamespace(), "Certificate.Name", certificate.GetName())
+		return true, err
+	}
+
+	log.Info("Successfully deleted Certificate", "Certificate.Namespace", certificate.GetNamespace(), "Certificate.Name", certificate.GetName())
+	return false, nil
+}
+
+func tlsEnabled(doclingServe *v1alpha1.DoclingServe) bool {
+	return doclingServe.Spec.APIServer.TLS != nil && doclingServe.Spec.APIServer.TLS.Enabled
+}
+
+// tlsProvider returns the provider of the docling-serve serving certificate, or an empty string when TLS is disabled.
+func tlsProvider(doclingServe *v1alpha1.DoclingServe) string {
+	if !tlsEnabled(doclingServe) {
+		return ""
+	}
+	if doclingServe.Spec.APIServer.TLS.Provider == "" {
+		return tlsProviderServiceCA
+	}
+	return doclingServe.Spec.APIServer.TLS.Provider
+}
+
+// tlsSecretName is the secret the serving certificate of docling-serve is issued in.
+func tlsSecretName(doclingServe *v1alpha1.DoclingServe) string {
+	return doclingServe.Name + "-tls"
+}
+
+// servicePortName returns the name of the docling-serve port, in the pod and in the service.
+func servicePortName(doclingServe *v1alpha1.DoclingServe) string {
+	if tlsEnabled(doclingServe) {
+		return "https"
+	}
+	return "http"
+}
@@ -0,0 +1,160 @@
+package reconcilers
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+
+	"github.io/docling-project/docling-operator/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// configHashAnnotation holds the hash of the config maps and secrets read by docling-serve on the pod template, so
+// that changing their content rolls the pods out.
+const configHashAnnotation = "docling.github.io/config-hash"
+
+// configReference is a config map or secret read by the docling-serve container.
+type configReference struct {
+	kind     string
+	name     string
+	optional bool
+}
+
+// configReferences returns the config maps and secrets docling-serve reads its configuration and credentials from.
+// Uvicorn loads its certificate on start only, so the serving certificate secret is part of them.
+func configReferences(doclingServe *v1alpha1.DoclingServe) []configReference {
+	var references []configReference
+	add := func(kind, name string, optional *bool) {
+		reference := configReference{kind: kind, name: name, optional: optional != nil && *optional}
+		for i := range references {
+			if references[i].kind == kind && references[i].name == name {
+				// An object is only optional when every reference to it is.
+				references[i].optional = references[i].optional && reference.optional
+				return
+			}
+		}
+		references = append(references, reference)
+	}
+
+	apiServer := doclingServe.Spec.APIServer
+	if apiServer.ConfigMapName != "" {
+		add("ConfigMap", apiServer.ConfigMapName, nil)
+	}
+	for _, envFrom := range apiServer.EnvFrom {
+		if envFrom.ConfigMapRef != nil {
+			add("ConfigMap", envFrom.ConfigMapRef.Name, envFrom.ConfigMapRef.Optional)
+		}
+		if envFrom.SecretRef != nil {
+			add("Secret", envFrom.SecretRef.Name, envFrom.SecretRef.Optional)
+		}
+	}
+	for _, env := range apiServer.Env {
+		if env.ValueFrom == nil {
+			continue
+		}
+		if env.ValueFrom.ConfigMapKeyRef != nil {
+			add("ConfigMap", env.ValueFrom.ConfigMapKeyRef.Name, env.ValueFrom.ConfigMapKeyRef.Optional)
+		}
+		if env.ValueFrom.SecretKeyRef != nil {
+			add("Secret", env.ValueFrom.SecretKeyRef.Name, env.ValueFrom.SecretKeyRef.Optional)
+		}
+	}
+	if rq := rqEngine(doclingServe); rq != nil && rq.Redis.URLSecretRef != nil {
+		add("Secret", rq.Redis.URLSecretRef.Name, rq.Redis.URLSecretRef.Optional)
+	}
+	// The generated API key is rolled out with its rotation annotation instead.
+	if authenticationEnabled(doclingServe) && !generatesAPIKey(doclingServe) {
+		add("Secret", apiKeySecretName(doclingServe), nil)
+	}
+	// The certificate is reported by its own conditions while it is being issued.
+	if tlsEnabled(doclingServe) {
+		optional := true
+		add("Secret", tlsSecretName(doclingServe), &optional)
+	}
+	return references
+}
+
+// ReferencedConfigMaps returns the names of the config maps, in the DoclingServe namespace, read by docling-serve.
+func ReferencedConfigMaps(doclingServe *v1alpha1.DoclingServe) []string {
+	return referencedNames(doclingServe, "ConfigMap")
+}
+
+// ReferencedSecrets returns the names of the secrets, in the DoclingServe namespace, read by docling-serve.
+func ReferencedSecrets(doclingServe *v1alpha1.DoclingServe) []string {
+	return referencedNames(doclingServe, "Secret")
+}
+
+func referencedNames(doclingServe *v1alpha1.DoclingServe, kind string) []string {
+	var names []string
+	for _, reference := range configReferences(doclingServe) {
+		if reference.kind == kind {
+			names = append(names, reference.name)
+		}
+	}
+	return names
+}
+
+// configHash hashes the content of the config maps and secrets read by docling-serve. It returns an empty hash when
+// no object is referenced, and the references to missing objects that are not optional.
+func configHash(ctx context.Context, c client.Client, doclingServe *v1alpha1.DoclingServe) (string, []string, error) {
+	references := configReferences(doclingServe)
+	if len(references) == 0 {
+		return "", nil, nil
+	}
+	sort.Slice(references, func(i, j int) bool {
+		if references[i].kind != references[j].kind {
+			return references[i].kind < references[j].kind
+		}
+		return references[i].name < references[j].name
+	})
+
+	hash := sha256.New()
+	var missing []string
+	for _, reference := range references {
+		key := types.NamespacedName{Name: reference.name, Namespace: doclingServe.Namespace}
+		data := map[string][]byte{}
+		var err error
+		if reference.kind == "ConfigMap" {
+			configMap := &corev1.ConfigMap{}
+			if err = c.Get(ctx, key, configMap); err == nil {
+				for k, v := range configMap.Data {
+					data[k] = []byte(v)
+				}
+				for k, v := range configMap.BinaryData {
+					data[k] = v
+				}
+			}
+		} else {
+			secret := &corev1.Secret{}
+			if err = c.Get(ctx, key, secret); err == nil {
+				data = secret.Data
+			}
+		}
+		if errors.IsNotFound(err) {
+			if !reference.optional {
+				missing = append(missing, fmt.Sprintf("%s %s", reference.kind, reference.name))
+			}
+			continue
+		} else if err != nil {
+			return "", nil, err
+		}
+
+		fmt.Fprintf(hash, "%s/%s\n", reference.kind, reference.name)
+		keys := make([]string, 0, len(data))
+		for k := range data {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			fmt.Fprintf(hash, "%s=%d:", k, len(data[k]))
+			hash.Write(data[k])
+			hash.Write([]byte("\n"))
+		}
+	}
+	return hex.EncodeToString(hash.Sum(nil)), missing, nil
+}
@@ -7,8 +7,10 @@ import (
 	"github.io/docling-project/docling-operator/api/v1alpha1"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -18,21 +20,35 @@ import (
 
 type DeploymentReconciler struct {
 	client.Client
-	Scheme *runtime.Scheme
+	Scheme       *runtime.Scheme
+	Capabilities Capabilities
 }
 
-func NewDeploymentReconciler(client client.Client, scheme *runtime.Scheme) *DeploymentReconciler {
+func NewDeploymentReconciler(client client.Client, scheme *runtime.Scheme, capabilities Capabilities) *DeploymentReconciler {
 	return &DeploymentReconciler{
-		Client: client,
-		Scheme: scheme,
+		Client:       client,
+		Scheme:       scheme,
+		Capabilities: capabilities,
 	}
 }
 
 func (r *DeploymentReconciler) Reconcile(ctx context.Context, doclingServe *v1alpha1.DoclingServe) (bool, error) {
 	log := logf.FromContext(ctx)
 
+	// The pods are only created once the models they mount are downloaded, the completion of the job or the readiness
+	// of the model cache triggers a reconcile.
+	pending, err := modelsPending(ctx, r.Client, doclingServe, doclingServe.Name+"-deployment")
+	if err != nil {
+		log.Error(err, "Error getting the state of the models", "DoclingServe.Namespace", doclingServe.Namespace, "DoclingServe.Name", doclingServe.Name)
+		return true, err
+	}
+	if pending {
+		log.Info("Waiting for the models download before creating Deployment", "Deployment.Namespace", doclingServe.Namespace, "Deployment.Name", doclingServe.Name+"-deployment")
+		return false, nil
+	}
+
 	deployment := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: doclingServe.Name + "-deployment", Namespace: doclingServe.Namespace}}
-	_, err := controllerutil.CreateOrUpdate(ctx, r.Client, deployment, func() error {
+	_, err = controllerutil.CreateOrUpdate(ctx, r.Client, deployment, func() error {
 		labels := labelsForDocling(doclingServe.Name)
 		if deployment.CreationTimestamp.IsZero() {
 			deployment.Spec.Selector = &metav1.LabelSelector{
@@ -40,13 +56,34 @@ func (r *DeploymentReconciler) Reconcile(ctx context.Context, doclingServe *v1al
 			}
 		}
 
-		deployment.Spec.Replicas = &doclingServe.Spec.APIServer.Instances
+		// The autoscaler owns the replica count while enabled, it is only seeded on creation.
+		if !autoscalingEnabled(doclingServe) {
+			deployment.Spec.Replicas = &doclingServe.Spec.APIServer.Instances
+		} else if deployment.CreationTimestamp.IsZero() {
+			deployment.Spec.Replicas = doclingServe.Spec.APIServer.Autoscaling.MinReplicas
+		}
+
+		probeScheme := corev1.URISchemeHTTP
+		if tlsEnabled(doclingServe) {
+			probeScheme = corev1.URISchemeHTTPS
+		}
+
+		podSecurity, err := podSecurityContext(doclingServe)
+		if err != nil {
+			return err
+		}
+		containerSecurity, err := containerSecurityContext(doclingServe)
+		if err != nil {
+			return err
+		}
+
 		deployment.Spec.Template = corev1.PodTemplateSpec{
 			ObjectMeta: metav1.ObjectMeta{
 				Labels: labels,
 			},
 			Spec: corev1.PodSpec{
-				ServiceAccountName: serviceAccountName,
+				ServiceAccountName: serviceAccountName(doclingServe),
+				SecurityContext:    podSecurity,
 				Containers: []corev1.Container{
 					{
 						Image: doclingServe.Spec.APIServer.Image,
@@ -57,18 +94,19 @@ func (r *DeploymentReconciler) Reconcile(ctx context.Context, doclingServe *v1al
 						},
 						Ports: []corev1.ContainerPort{
 							{
-								ContainerPort: 5001,
-								Name:          "http",
+								ContainerPort: doclingServePort,
+								Name:          servicePortName(doclingServe),
 								Protocol:      corev1.ProtocolTCP,
 							},
 						},
 						ImagePullPolicy: corev1.PullIfNotPresent,
+						SecurityContext: containerSecurity,
 						LivenessProbe: &corev1.Probe{
 							ProbeHandler: corev1.ProbeHandler{
 								HTTPGet: &corev1.HTTPGetAction{
 									Path:   "/health",
-									Port:   intstr.FromString("http"),
-									Scheme: corev1.URISchemeHTTP,
+									Port:   intstr.FromString(servicePortName(doclingServe)),
+									Scheme: probeScheme,
 								},
 							},
 							InitialDelaySeconds: 3,
@@ -81,8 +119,8 @@ func (r *DeploymentReconciler) Reconcile(ctx context.Context, doclingServe *v1al
 							ProbeHandler: corev1.ProbeHandler{
 								HTTPGet: &corev1.HTTPGetAction{
 									Path:   "/health",
-									Port:   intstr.FromString("http"),
-									Scheme: corev1.URISchemeHTTP,
+									Port:   intstr.FromString(servicePortName(doclingServe)),
+									Scheme: probeScheme,
 								},
 							},
 							InitialDelaySeconds: 10,
@@ -103,25 +141,15 @@ func (r *DeploymentReconciler) Reconcile(ctx context.Context, doclingServe *v1al
 			}}...)
 		}
 
-		if doclingServe.Spec.Engine.Local != nil {
-			deployment.Spec.Template.Spec.Containers[0].Env = append(deployment.Spec.Template.Spec.Containers[0].Env, []corev1.EnvVar{{
-				Name:  "DOCLING_SERVE_ENG_LOC_NUM_WORKERS",
-				Value: strconv.Itoa(int(doclingServe.Spec.Engine.Local.NumWorkers)),
-			}}...)
-		}
+		applyScheduling(doclingServe, &deployment.Spec.Template.Spec)
 
-		if doclingServe.Spec.Engine.KFP != nil {
-			deployment.Spec.Template.Spec.Containers[0].Env = append(deployment.Spec.Template.Spec.Containers[0].Env,
-				[]corev1.EnvVar{
-					{
-						Name:  "DOCLING_SERVE_ENG_KFP_ENDPOINT",
-						Value: doclingServe.Spec.Engine.KFP.Endpoint,
-					},
-					{
-						Name:  "DOCLING_SERVE_ENG_KIND",
-						Value: "kfp",
-					}}...)
+		settingsEnv, err := settingsEnv(doclingServe)
+		if err != nil {
+			return err
 		}
+		deployment.Spec.Template.Spec.Containers[0].Env = append(deployment.Spec.Template.Spec.Containers[0].Env, settingsEnv...)
+
+		deployment.Spec.Template.Spec.Containers[0].Env = append(deployment.Spec.Template.Spec.Containers[0].Env, engineEnv(doclingServe)...)
 
 		if len(doclingServe.Spec.APIServer.ConfigMapName) > 0 {
 			deployment.Spec.Template.Spec.Containers[0].EnvFrom = append(deployment.Spec.Template.Spec.Containers[0].EnvFrom, []corev1.EnvFromSource{{
@@ -132,10 +160,85 @@ func (r *DeploymentReconciler) Reconcile(ctx context.Context, doclingServe *v1al
 			}}...)
 		}
 
+		if authenticationEnabled(doclingServe) {
+			deployment.Spec.Template.Spec.Containers[0].Env = append(deployment.Spec.Template.Spec.Containers[0].Env, []corev1.EnvVar{{
+				Name: apiKeyEnv,
+				ValueFrom: &corev1.EnvVarSource{
+					SecretKeyRef: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: apiKeySecretName(doclingServe)},
+						Key:                  apiKeySecretKey(doclingServe),
+					},
+				},
+			}}...)
+		}
+
+		// Environment variables are only read on start, a rotated API key rolls the pods out.
+		annotations := map[string]string{}
+		if generatesAPIKey(doclingServe) {
+			secret := &corev1.Secret{}
+			if err := r.Get(ctx, types.NamespacedName{Name: apiKeySecretName(doclingServe), Namespace: doclingServe.Namespace}, secret); err != nil && !errors.IsNotFound(err) {
+				return err
+			}
+			if rotatedAt, ok := secret.Annotations[apiKeyRotatedAtAnnotation]; ok {
+				annotations[apiKeyRotatedAtAnnotation] = rotatedAt
+			}
+		}
+
+		// So does a change to the config maps and secrets docling-serve reads.
+		hash, _, err := configHash(ctx, r.Client, doclingServe)
+		if err != nil {
+			return err
+		}
+		if hash != "" {
+			annotations[configHashAnnotation] = hash
+		}
+		if len(annotations) > 0 {
+			deployment.Spec.Template.Annotations = annotations
+		}
+
 		if doclingServe.Spec.APIServer.Resources != nil {
 			deployment.Spec.Template.Spec.Containers[0].Resources = *doclingServe.Spec.APIServer.Resources
 		}
 
+		addDoclingVolumes(doclingServe, &deployment.Spec.Template.Spec, containerSecurity, r.Capabilities)
+
+		if tlsEnabled(doclingServe) {
+			deployment.Spec.Template.Spec.Containers[0].Env = append(deployment.Spec.Template.Spec.Containers[0].Env,
+				[]corev1.EnvVar{
+					{
+						Name:  "UVICORN_SSL_CERTFILE",
+						Value: tlsMountPath + "/" + corev1.TLSCertKey,
+					},
+					{
+						Name:  "UVICORN_SSL_KEYFILE",
+						Value: tlsMountPath + "/" + corev1.TLSPrivateKeyKey,
+					}}...)
+			deployment.Spec.Template.Spec.Containers[0].VolumeMounts = append(deployment.Spec.Template.Spec.Containers[0].VolumeMounts, corev1.VolumeMount{
+				Name:      "tls",
+				MountPath: tlsMountPath,
+				ReadOnly:  true,
+			})
+			deployment.Spec.Template.Spec.Volumes = append(deployment.Spec.Template.Spec.Volumes, corev1.Volume{
+				Name: "tls",
+				VolumeSource: corev1.VolumeSource{
+					Secret: &corev1.SecretVolumeSource{SecretName: tlsSecretName(doclingServe)},
+				},
+			})
+		}
+
+		// The user variables come last, the webhook rejects the names the operator sets.
+		deployment.Spec.Template.Spec.Containers[0].Env = append(deployment.Spec.Template.Spec.Containers[0].Env, doclingServe.Spec.APIServer.Env...)
+		deployment.Spec.Template.Spec.Containers[0].EnvFrom = append(deployment.Spec.Template.Spec.Containers[0].EnvFrom, doclingServe.Spec.APIServer.EnvFrom...)
+
+		if oauthProxyEnabled(doclingServe) {
+			container, err := oauthProxyContainer(doclingServe)
+			if err != nil {
+				return err
+			}
+			deployment.Spec.Template.Spec.Containers = append(deployment.Spec.Template.Spec.Containers, container)
+			deployment.Spec.Template.Spec.Volumes = append(deployment.Spec.Template.Spec.Volumes, oauthProxyVolumes(doclingServe)...)
+		}
+
 		_ = ctrl.SetControllerReference(doclingServe, deployment, r.Scheme)
 
 		return nil
@@ -150,6 +253,62 @@ func (r *DeploymentReconciler) Reconcile(ctx context.Context, doclingServe *v1al
 	return false, nil
 }
 
+// engineEnv returns the variables configuring the compute engine of docling-serve.
+func engineEnv(doclingServe *v1alpha1.DoclingServe) []corev1.EnvVar {
+	engine := doclingServe.Spec.Engine
+	switch {
+	case engine.Local != nil:
+		return []corev1.EnvVar{{
+			Name:  "DOCLING_SERVE_ENG_LOC_NUM_WORKERS",
+			Value: strconv.Itoa(int(engine.Local.NumWorkers)),
+		}}
+	case engine.KFP != nil:
+		return []corev1.EnvVar{
+			{
+				Name:  "DOCLING_SERVE_ENG_KFP_ENDPOINT",
+				Value: engine.KFP.Endpoint,
+			},
+			{
+				Name:  "DOCLING_SERVE_ENG_KIND",
+				Value: "kfp",
+			}}
+	case engine.RQ != nil:
+		return append([]corev1.EnvVar{{
+			Name:  "DOCLING_SERVE_ENG_KIND",
+			Value: "rq",
+		}}, redisEnv(doclingServe)...)
+	}
+	return nil
+}
+
+// addDoclingVolumes mounts the models and, when the root filesystem is read-only, the writable directories in the
+// docling-serve container of the pod.
+func addDoclingVolumes(doclingServe *v1alpha1.DoclingServe, podSpec *corev1.PodSpec, containerSecurity *corev1.SecurityContext, capabilities Capabilities) {
+	container := &podSpec.Containers[0]
+	if modelsEnabled(doclingServe) {
+		volume, mount, artifactsPath := modelsVolume(doclingServe)
+		container.Env = append(container.Env, corev1.EnvVar{
+			Name:  artifactsPathEnv,
+			Value: artifactsPath,
+		})
+		container.VolumeMounts = append(container.VolumeMounts, mount)
+		podSpec.Volumes = append(podSpec.Volumes, volume)
+		if initContainer := modelsInitContainer(doclingServe, podSpec.SecurityContext, containerSecurity, capabilities); initContainer != nil {
+			podSpec.InitContainers = append(podSpec.InitContainers, *initContainer)
+		}
+	}
+
+	if readOnlyRootFilesystem(containerSecurity) {
+		volumes, mounts := writableVolumes()
+		container.Env = append(container.Env, corev1.EnvVar{
+			Name:  "XDG_CACHE_HOME",
+			Value: cacheMountPath,
+		})
+		container.VolumeMounts = append(container.VolumeMounts, mounts...)
+		podSpec.Volumes = append(podSpec.Volumes, volumes...)
+	}
+}
+
 func labelsForDocling(name string) map[string]string {
 	return map[string]string{"app": "docling-serve", "doclingserve_cr": name}
 }
@@ -0,0 +1,136 @@
+package reconcilers
+
+import (
+	"context"
+
+	"github.io/docling-project/docling-operator/api/v1alpha1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+const (
+	autoscalingModeResource = "Resource"
+	autoscalingModeKEDA     = "KEDA"
+
+	// defaultTargetCPUUtilization mirrors the API server default applied when an autoscaler has no metrics.
+	defaultTargetCPUUtilization = 80
+)
+
+type HorizontalPodAutoscalerReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+}
+
+func NewHorizontalPodAutoscalerReconciler(client client.Client, scheme *runtime.Scheme) *HorizontalPodAutoscalerReconciler {
+	return &HorizontalPodAutoscalerReconciler{
+		Client: client,
+		Scheme: scheme,
+	}
+}
+
+func (r *HorizontalPodAutoscalerReconciler) Reconcile(ctx context.Context, doclingServe *v1alpha1.DoclingServe) (bool, error) {
+	if autoscalingMode(doclingServe) == autoscalingModeResource {
+		return r.createOrUpdate(ctx, doclingServe)
+	}
+
+	return r.delete(ctx, doclingServe)
+}
+
+func (r *HorizontalPodAutoscalerReconciler) createOrUpdate(ctx context.Context, doclingServe *v1alpha1.DoclingServe) (bool, error) {
+	log := logf.FromContext(ctx)
+	spec := doclingServe.Spec.APIServer.Autoscaling
+	hpa := &autoscalingv2.HorizontalPodAutoscaler{ObjectMeta: metav1.ObjectMeta{Name: doclingServe.Name + "-hpa", Namespace: doclingServe.Namespace}}
+	_, err := controllerutil.CreateOrUpdate(ctx, r.Client, hpa, func() error {
+		hpa.Labels = labelsForDocling(doclingServe.Name)
+		hpa.Spec.ScaleTargetRef = autoscalingv2.CrossVersionObjectReference{
+			APIVersion: "apps/v1",
+			Kind:       "Deployment",
+			Name:       doclingServe.Name + "-deployment",
+		}
+		hpa.Spec.MinReplicas = spec.MinReplicas
+		hpa.Spec.MaxReplicas = spec.MaxReplicas
+
+		targetCPU := spec.TargetCPUUtilizationPercentage
+		if targetCPU == nil && spec.TargetMemoryUtilizationPercentage == nil {
+			targetCPU = ptr.To(int32(defaultTargetCPUUtilization))
+		}
+		hpa.Spec.Metrics = nil
+		if targetCPU != nil {
+			hpa.Spec.Metrics = append(hpa.Spec.Metrics, utilizationMetric(corev1.ResourceCPU, *targetCPU))
+		}
+		if spec.TargetMemoryUtilizationPercentage != nil {
+			hpa.Spec.Metrics = append(hpa.Spec.Metrics, utilizationMetric(corev1.ResourceMemory, *spec.TargetMemoryUtilizationPercentage))
+		}
+
+		// Keep the behavior defaulted by the API server unless one is configured.
+		if spec.Behavior != nil {
+			hpa.Spec.Behavior = spec.Behavior
+		}
+		_ = ctrl.SetControllerReference(doclingServe, hpa, r.Scheme)
+		return nil
+	})
+	if err != nil {
+		log.Error(err, "Error creating/updating HorizontalPodAutoscaler", "HorizontalPodAutoscaler.Namespace", hpa.Namespace, "HorizontalPodAutoscaler.Name", hpa.Name)
+		return true, err
+	}
+
+	log.Info("Successfully created/updated HorizontalPodAutoscaler", "HorizontalPodAutoscaler.Namespace", hpa.Namespace, "HorizontalPodAutoscaler.Name", hpa.Name)
+	return false, nil
+}
+
+func (r *HorizontalPodAutoscalerReconciler) delete(ctx context.Context, doclingServe *v1alpha1.DoclingServe) (bool, error) {
+	log := logf.FromContext(ctx)
+	hpa := &autoscalingv2.HorizontalPodAutoscaler{ObjectMeta: metav1.ObjectMeta{Name: doclingServe.Name + "-hpa", Namespace: doclingServe.Namespace}}
+	if err := r.Get(ctx, types.NamespacedName{Name: doclingServe.Name + "-hpa", Namespace: doclingServe.Namespace}, hpa); err != nil && !errors.IsNotFound(err) {
+		log.Error(err, "Error deleting HorizontalPodAutoscaler", "HorizontalPodAutoscaler.Namespace", hpa.Namespace, "HorizontalPodAutoscaler.Name", hpa.Name)
+		return true, err
+	} else if errors.IsNotFound(err) {
+		return false, nil
+	}
+
+	if err := r.Delete(ctx, hpa); err != nil {
+		log.Error(err, "Error deleting HorizontalPodAutoscaler", "HorizontalPodAutoscaler.Namespace", hpa.Namespace, "HorizontalPodAutoscaler.Name", hpa.Name)
+		return true, err
+	}
+
+	log.Info("Successfully deleted HorizontalPodAutoscaler", "HorizontalPodAutoscaler.Namespace", hpa.Namespace, "HorizontalPodAutoscaler.Name", hpa.Name)
+	return false, nil
+}
+
+// autoscalingEnabled reports whether the replica count of the docling-serve pods is managed by an autoscaler.
+func autoscalingEnabled(doclingServe *v1alpha1.DoclingServe) bool {
+	return doclingServe.Spec.APIServer.Autoscaling != nil && doclingServe.Spec.APIServer.Autoscaling.Enabled
+}
+
+// autoscalingMode returns the autoscaler managing the docling-serve pods, or an empty string when autoscaling is disabled.
+func autoscalingMode(doclingServe *v1alpha1.DoclingServe) string {
+	if !autoscalingEnabled(doclingServe) {
+		return ""
+	}
+	if doclingServe.Spec.APIServer.Autoscaling.Mode == "" {
+		return autoscalingModeResource
+	}
+	return doclingServe.Spec.APIServer.Autoscaling.Mode
+}
+
+func utilizationMetric(resource corev1.ResourceName, averageUtilization int32) autoscalingv2.MetricSpec {
+	return autoscalingv2.MetricSpec{
+		Type: autoscalingv2.ResourceMetricSourceType,
+		Resource: &autoscalingv2.ResourceMetricSource{
+			Name: resource,
+			Target: autoscalingv2.MetricTarget{
+				Type:               autoscalingv2.UtilizationMetricType,
+				AverageUtilization: ptr.To(averageUtilization),
+			},
+		},
+	}
+}
@@ -0,0 +1,124 @@
+package reconcilers
+
+import (
+	"context"
+
+	"github.io/docling-project/docling-operator/api/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+type HTTPRouteReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+}
+
+func NewHTTPRouteReconciler(client client.Client, scheme *runtime.Scheme) *HTTPRouteReconciler {
+	return &HTTPRouteReconciler{
+		Client: client,
+		Scheme: scheme,
+	}
+}
+
+func (r *HTTPRouteReconciler) Reconcile(ctx context.Context, doclingServe *v1alpha1.DoclingServe) (bool, error) {
+	if doclingServe.Spec.Gateway != nil && doclingServe.Spec.Gateway.Enabled {
+		return r.createOrUpdate(ctx, doclingServe)
+	}
+
+	return r.delete(ctx, doclingServe)
+}
+
+func (r *HTTPRouteReconciler) createOrUpdate(ctx context.Context, doclingServe *v1alpha1.DoclingServe) (bool, error) {
+	log := logf.FromContext(ctx)
+	spec := doclingServe.Spec.Gateway
+	httpRoute := &gatewayv1.HTTPRoute{ObjectMeta: metav1.ObjectMeta{Name: doclingServe.Name + "-httproute", Namespace: doclingServe.Namespace}}
+	_, err := controllerutil.CreateOrUpdate(ctx, r.Client, httpRoute, func() error {
+		httpRoute.Labels = labelsForDocling(doclingServe.Name)
+		httpRoute.Spec = gatewayv1.HTTPRouteSpec{
+			CommonRouteSpec: gatewayv1.CommonRouteSpec{
+				ParentRefs: []gatewayv1.ParentReference{parentRefForGateway(spec)},
+			},
+			Rules: []gatewayv1.HTTPRouteRule{
+				{
+					// Spell out the API server defaults so that updates do not flap.
+					Matches: []gatewayv1.HTTPRouteMatch{
+						{
+							Path: &gatewayv1.HTTPPathMatch{
+								Type:  ptr.To(gatewayv1.PathMatchPathPrefix),
+								Value: ptr.To("/"),
+							},
+						},
+					},
+					BackendRefs: []gatewayv1.HTTPBackendRef{
+						{
+							BackendRef: gatewayv1.BackendRef{
+								BackendObjectReference: gatewayv1.BackendObjectReference{
+									Group: ptr.To(gatewayv1.Group("")),
+									Kind:  ptr.To(gatewayv1.Kind("Service")),
+									Name:  gatewayv1.ObjectName(doclingServe.Name + "-service"),
+									// Backend references take a port number rather than the servicePortName name.
+									Port: ptr.To(gatewayv1.PortNumber(doclingServePort)),
+								},
+								Weight: ptr.To(int32(1)),
+							},
+						},
+					},
+				},
+			},
+		}
+		for _, hostname := range spec.Hostnames {
+			httpRoute.Spec.Hostnames = append(httpRoute.Spec.Hostnames, gatewayv1.Hostname(hostname))
+		}
+		_ = ctrl.SetControllerReference(doclingServe, httpRoute, r.Scheme)
+		return nil
+	})
+	if err != nil {
+		log.Error(err, "Error creating/updating HTTPRoute", "HTTPRoute.Namespace", httpRoute.Namespace, "HTTPRoute.Name", httpRoute.Name)
+		return true, err
+	}
+
+	log.Info("Successfully created/updated HTTPRoute", "HTTPRoute.Namespace", httpRoute.Namespace, "HTTPRoute.Name", httpRoute.Name)
+	return false, nil
+}
+
+func (r *HTTPRouteReconciler) delete(ctx context.Context, doclingServe *v1alpha1.DoclingServe) (bool, error) {
+	log := logf.FromContext(ctx)
+	httpRoute := &gatewayv1.HTTPRoute{ObjectMeta: metav1.ObjectMeta{Name: doclingServe.Name + "-httproute", Namespace: doclingServe.Namespace}}
+	if err := r.Get(ctx, types.NamespacedName{Name: doclingServe.Name + "-httproute", Namespace: doclingServe.Namespace}, httpRoute); err != nil && !errors.IsNotFound(err) {
+		log.Error(err, "Error deleting HTTPRoute", "HTTPRoute.Namespace", httpRoute.Namespace, "HTTPRoute.Name", httpRoute.Name)
+		return true, err
+	} else if errors.IsNotFound(err) {
+		return false, nil
+	}
+
+	if err := r.Delete(ctx, httpRoute); err != nil {
+		log.Error(err, "Error deleting HTTPRoute", "HTTPRoute.Namespace", httpRoute.Namespace, "HTTPRoute.Name", httpRoute.Name)
+		return true, err
+	}
+
+	log.Info("Successfully deleted HTTPRoute", "HTTPRoute.Namespace", httpRoute.Namespace, "HTTPRoute.Name", httpRoute.Name)
+	return false, nil
+}
+
+func parentRefForGateway(gateway *v1alpha1.Gateway) gatewayv1.ParentReference {
+	parentRef := gatewayv1.ParentReference{
+		Group: ptr.To(gatewayv1.Group(gatewayv1.GroupName)),
+		Kind:  ptr.To(gatewayv1.Kind("Gateway")),
+		Name:  gatewayv1.ObjectName(gateway.Name),
+	}
+	if gateway.Namespace != "" {
+		parentRef.Namespace = ptr.To(gatewayv1.Namespace(gateway.Namespace))
+	}
+	if gateway.SectionName != "" {
+		parentRef.SectionName = ptr.To(gatewayv1.SectionName(gateway.SectionName))
+	}
+	return parentRef
+}
@@ -0,0 +1,115 @@
+package reconcilers
+
+import (
+	"context"
+
+	"github.io/docling-project/docling-operator/api/v1alpha1"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+type IngressReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+}
+
+func NewIngressReconciler(client client.Client, scheme *runtime.Scheme) *IngressReconciler {
+	return &IngressReconciler{
+		Client: client,
+		Scheme: scheme,
+	}
+}
+
+func (r *IngressReconciler) Reconcile(ctx context.Context, doclingServe *v1alpha1.DoclingServe) (bool, error) {
+	if doclingServe.Spec.Ingress != nil && doclingServe.Spec.Ingress.Enabled {
+		return r.createOrUpdate(ctx, doclingServe)
+	}
+
+	return r.delete(ctx, doclingServe)
+}
+
+func (r *IngressReconciler) createOrUpdate(ctx context.Context, doclingServe *v1alpha1.DoclingServe) (bool, error) {
+	log := logf.FromContext(ctx)
+	spec := doclingServe.Spec.Ingress
+	ingress := &networkingv1.Ingress{ObjectMeta: metav1.ObjectMeta{Name: doclingServe.Name + "-ingress", Namespace: doclingServe.Namespace}}
+	_, err := controllerutil.CreateOrUpdate(ctx, r.Client, ingress, func() error {
+		ingress.Labels = labelsForDocling(doclingServe.Name)
+		mergeManagedAnnotations(ingress, spec.Annotations)
+
+		path := spec.Path
+		if path == "" {
+			path = "/"
+		}
+		pathType := networkingv1.PathTypePrefix
+		ingress.Spec = networkingv1.IngressSpec{
+			Rules: []networkingv1.IngressRule{
+				{
+					Host: spec.Host,
+					IngressRuleValue: networkingv1.IngressRuleValue{
+						HTTP: &networkingv1.HTTPIngressRuleValue{
+							Paths: []networkingv1.HTTPIngressPath{
+								{
+									Path:     path,
+									PathType: &pathType,
+									Backend: networkingv1.IngressBackend{
+										Service: &networkingv1.IngressServiceBackend{
+											Name: doclingServe.Name + "-service",
+											Port: networkingv1.ServiceBackendPort{
+												Name: servicePortName(doclingServe),
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+		if spec.IngressClassName != "" {
+			ingress.Spec.IngressClassName = &spec.IngressClassName
+		}
+		if spec.TLSSecretName != "" {
+			tls := networkingv1.IngressTLS{SecretName: spec.TLSSecretName}
+			if spec.Host != "" {
+				tls.Hosts = []string{spec.Host}
+			}
+			ingress.Spec.TLS = []networkingv1.IngressTLS{tls}
+		}
+		_ = ctrl.SetControllerReference(doclingServe, ingress, r.Scheme)
+		return nil
+	})
+	if err != nil {
+		log.Error(err, "Error creating/updating Ingress", "Ingress.Namespace", ingress.Namespace, "Ingress.Name", ingress.Name)
+		return true, err
+	}
+
+	log.Info("Successfully created/updated Ingress", "Ingress.Namespace", ingress.Namespace, "Ingress.Name", ingress.Name)
+	return false, nil
+}
+
+func (r *IngressReconciler) delete(ctx context.Context, doclingServe *v1alpha1.DoclingServe) (bool, error) {
+	log := logf.FromContext(ctx)
+	ingress := &networkingv1.Ingress{ObjectMeta: metav1.ObjectMeta{Name: doclingServe.Name + "-ingress", Namespace: doclingServe.Namespace}}
+	if err := r.Get(ctx, types.NamespacedName{Name: doclingServe.Name + "-ingress", Namespace: doclingServe.Namespace}, ingress); err != nil && !errors.IsNotFound(err) {
+		log.Error(err, "Error deleting Ingress", "Ingress.Namespace", ingress.Namespace, "Ingress.Name", ingress.Name)
+		return true, err
+	} else if errors.IsNotFound(err) {
+		return false, nil
+	}
+
+	if err := r.Delete(ctx, ingress); err != nil {
+		log.Error(err, "Error deleting Ingress", "Ingress.Namespace", ingress.Namespace, "Ingress.Name", ingress.Name)
+		return true, err
+	}
+
+	log.Info("Successfully deleted Ingress", "Ingress.Namespace", ingress.Namespace, "Ingress.Name", ingress.Name)
+	return false, nil
+}
@@ -0,0 +1,297 @@
+package reconcilers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.io/docling-project/docling-operator/api/v1alpha1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// modelCacheDownloadScript downloads the bundles passed as arguments, then writes the inventory of the cache to the
+// termination message of the container, where the operator reads it from.
+const modelCacheDownloadScript = `set -e
+docling-tools models download --output-dir /models "$@"
+models=$(ls -1 /models | grep -vx 'lost+found' | sed 's/.*/"&"/' | paste -sd, -)
+printf '{"models":[%s],"sizeKiB":%s}' "$models" "$(du -sk /models | cut -f1)" > /dev/termination-log
+`
+
+// modelCacheInventory is the termination message of the download container.
+type modelCacheInventory struct {
+	Models  []string `json:"models"`
+	SizeKiB int64    `json:"sizeKiB"`
+}
+
+// ModelCacheReconciler provisions the shared volume of a DoclingModelCache, runs the job downloading the models into
+// it and reports the downloaded models in its status.
+type ModelCacheReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+}
+
+func NewModelCacheReconciler(client client.Client, scheme *runtime.Scheme) *ModelCacheReconciler {
+	return &ModelCacheReconciler{
+		Client: client,
+		Scheme: scheme,
+	}
+}
+
+func (r *ModelCacheReconciler) Reconcile(ctx context.Context, cache *v1alpha1.DoclingModelCache) (bool, error) {
+	requeue, err := r.createOrUpdate(ctx, cache)
+	if statusErr := r.updateStatus(ctx, cache); statusErr != nil {
+		return true, statusErr
+	}
+	return requeue, err
+}
+
+func (r *ModelCacheReconciler) createOrUpdate(ctx context.Context, cache *v1alpha1.DoclingModelCache) (bool, error) {
+	size := resource.MustParse("20Gi")
+	if cache.Spec.Size != nil {
+		size = *cache.Spec.Size
+	}
+	pvc := &corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{Name: modelCachePVCName(cache.Name), Namespace: cache.Namespace, Labels: labelsForModelCache(cache.Name)},
+		Spec: corev1.PersistentVolumeClaimSpec{
+			// The pods of every DoclingServe referencing the cache mount it, on any node.
+			AccessModes:      []corev1.PersistentVolumeAccessMode{corev1.ReadWriteMany},
+			StorageClassName: cache.Spec.StorageClassName,
+			Resources:        corev1.VolumeResourceRequirements{Requests: corev1.ResourceList{corev1.ResourceStorage: size}},
+		},
+	}
+	if err := ensureDownloadVolume(ctx, r.Client, r.Scheme, cache, pvc); err != nil {
+		return true, err
+	}
+
+	job, err := r.downloadJob(cache)
+	if err != nil {
+		return true, err
+	}
+	return ensureDownloadJob(ctx, r.Client, job)
+}
+
+// downloadJob builds the job running docling-tools models download into the cache volume.
+func (r *ModelCacheReconciler) downloadJob(cache *v1alpha1.DoclingModelCache) (*batchv1.Job, error) {
+	hash, err := modelCacheHash(cache)
+	if err != nil {
+		return nil, err
+	}
+
+	podSecurity, err := overlay(defaultPodSecurityContext(), cache.Spec.PodSecurityContext)
+	if err != nil {
+		return nil, err
+	}
+	securityContext, err := overlay(defaultSecurityContext(), cache.Spec.SecurityContext)
+	if err != nil {
+		return nil, err
+	}
+
+	command := []string{"/bin/sh", "-c", modelCacheDownloadScript, "download"}
+	container := corev1.Container{
+		Name:            "download",
+		Image:           cache.Spec.Image,
+		Command:         append(command, cache.Spec.Bundles...),
+		ImagePullPolicy: corev1.PullIfNotPresent,
+		SecurityContext: securityContext,
+		VolumeMounts: []corev1.VolumeMount{{
+			Name:      "models",
+			MountPath: modelsMountPath,
+		}},
+	}
+	volumes := []corev1.Volume{{
+		Name: "models",
+		VolumeSource: corev1.VolumeSource{
+			PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: modelCachePVCName(cache.Name)},
+		},
+	}}
+	if readOnlyRootFilesystem(securityContext) {
+		writable, mounts := writableVolumes()
+		container.Env = append(container.Env, corev1.EnvVar{Name: "XDG_CACHE_HOME", Value: cacheMountPath})
+		container.VolumeMounts = append(container.VolumeMounts, mounts...)
+		volumes = append(volumes, writable...)
+	}
+	if cache.Spec.HFTokenSecretRef != nil {
+		container.Env = append(container.Env, corev1.EnvVar{
+			Name:      "HF_TOKEN",
+			ValueFrom: &corev1.EnvVarSource{SecretKeyRef: cache.Spec.HFTokenSecretRef},
+		})
+	}
+
+	labels := labelsForModelCache(cache.Name)
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        modelCacheJobName(cache.Name),
+			Namespace:   cache.Namespace,
+			Labels:      labels,
+			Annotations: map[string]string{modelsHashAnnotation: hash},
+		},
+		Spec: batchv1.JobSpec{
+			BackoffLimit: ptr.To(int32(3)),
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: labels},
+				Spec: corev1.PodSpec{
+					RestartPolicy:   corev1.RestartPolicyOnFailure,
+					SecurityContext: podSecurity,
+					NodeSelector:    cache.Spec.NodeSelector,
+					Tolerations:     cache.Spec.Tolerations,
+					Containers:      []corev1.Container{container},
+					Volumes:         volumes,
+				},
+			},
+		},
+	}
+	_ = ctrl.SetControllerReference(cache, job, r.Scheme)
+	return job, nil
+}
+
+// updateStatus reports whether the current models are downloaded, and the inventory of the cache once they are.
+func (r *ModelCacheReconciler) updateStatus(ctx context.Context, cache *v1alpha1.DoclingModelCache) error {
+	log := logf.FromContext(ctx)
+
+	cache.Status.ObservedGeneration = cache.Generation
+	cache.Status.ClaimName = modelCachePVCName(cache.Name)
+	condition := metav1.Condition{
+		Type:               "Ready",
+		Status:             metav1.ConditionFalse,
+		ObservedGeneration: cache.Generation,
+		Reason:             "Downloading",
+		Message:            "The docling models are being downloaded",
+	}
+	job, err := r.currentJob(ctx, cache)
+	switch {
+	case err != nil:
+		log.Error(err, "failed to get the model cache download job")
+		condition.Status = metav1.ConditionUnknown
+		condition.Reason = "ModelCacheStatusError"
+		condition.Message = err.Error()
+	case job != nil && jobConditionTrue(job, batchv1.JobComplete):
+		condition.Status = metav1.ConditionTrue
+		condition.Reason = "Downloaded"
+		condition.Message = "The docling models were downloaded"
+		inventory, err := r.inventory(ctx, job)
+		if err != nil {
+			log.Error(err, "failed to read the model cache inventory")
+		} else if inventory != nil {
+			cache.Status.Models = inventory.Models
+			cache.Status.Size = resource.NewQuantity(inventory.SizeKiB*1024, resource.BinarySI)
+		}
+	case job != nil && jobConditionTrue(job, batchv1.JobFailed):
+		condition.Reason = "DownloadFailed"
+		condition.Message = fmt.Sprintf("The docling models download job %s failed, check its logs", job.Name)
+	}
+	meta.SetStatusCondition(&cache.Status.Conditions, condition)
+
+	if err := r.Status().Update(ctx, cache); err != nil {
+		if errors.IsConflict(err) {
+			log.Info("conflict updating doclingModelCache status")
+		} else {
+			log.Error(err, "failed to update doclingModelCache status")
+		}
+		return err
+	}
+	log.Info("updated doclingModelCache status")
+	return nil
+}
+
+// currentJob returns the download job of the current models, or nil when it does not exist yet.
+func (r *ModelCacheReconciler) currentJob(ctx context.Context, cache *v1alpha1.DoclingModelCache) (*batchv1.Job, error) {
+	job := &batchv1.Job{}
+	if err := r.Get(ctx, types.NamespacedName{Name: modelCacheJobName(cache.Name), Namespace: cache.Namespace}, job); err != nil {
+		if errors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	hash, err := modelCacheHash(cache)
+	if err != nil {
+		return nil, err
+	}
+	if job.Annotations[modelsHashAnnotation] != hash {
+		return nil, nil
+	}
+	return job, nil
+}
+
+// inventory reads the inventory written by the succeeded pod of the job. It returns nil when the pod is gone, so the
+// inventory already reported is kept.
+func (r *ModelCacheReconciler) inventory(ctx context.Context, job *batchv1.Job) (*modelCacheInventory, error) {
+	pods := &corev1.PodList{}
+	if err := r.List(ctx, pods, client.InNamespace(job.Namespace), client.MatchingLabels{batchv1.ControllerUidLabel: string(job.UID)}); err != nil {
+		return nil, err
+	}
+	for _, pod := range pods.Items {
+		if pod.Status.Phase != corev1.PodSucceeded {
+			continue
+		}
+		for _, status := range pod.Status.ContainerStatuses {
+			if status.Name != "download" || status.State.Terminated == nil || status.State.Terminated.Message == "" {
+				continue
+			}
+			inventory := &modelCacheInventory{}
+			if err := json.Unmarshal([]byte(status.State.Terminated.Message), inventory); err != nil {
+				return nil, fmt.Errorf("invalid inventory in the termination message of pod %s: %w", pod.Name, err)
+			}
+			return inventory, nil
+		}
+	}
+	return nil, nil
+}
+
+// labelsForModelCache labels the cache resources, the job pods are not selected by the docling-serve services and
+// network policies.
+func labelsForModelCache(name string) map[string]string {
+	return map[string]string{"app": "docling-model-cache", "doclingmodelcache_cr": name}
+}
+
+func modelCachePVCName(name string) string {
+	return name + "-model-cache"
+}
+
+func modelCacheJobName(name string) string {
+	return name + "-model-cache-download"
+}
+
+// modelCacheHash identifies the download of the cache. Its image and its pod settings only serve the download, so
+// changing them replaces the job, which cannot be updated, and downloads the models again.
+func modelCacheHash(cache *v1alpha1.DoclingModelCache) (string, error) {
+	return downloadHash(struct {
+		Image            string
+		Bundles          []string
+		HFTokenSecretRef *corev1.SecretKeySelector
+		// The pod settings are left out while unset, so that adding them does not change the hash of existing caches.
+		PodSecurityContext *corev1.PodSecurityContext `json:",omitempty"`
+		SecurityContext    *corev1.SecurityContext    `json:",omitempty"`
+		NodeSelector       map[string]string          `json:",omitempty"`
+		Tolerations        []corev1.Toleration        `json:",omitempty"`
+	}{cache.Spec.Image, cache.Spec.Bundles, cache.Spec.HFTokenSecretRef, cache.Spec.PodSecurityContext,
+		cache.Spec.SecurityContext, cache.Spec.NodeSelector, cache.Spec.Tolerations})
+}
+
+// modelCache returns the DoclingModelCache referenced by the DoclingServe, or nil when it does not exist.
+func modelCache(ctx context.Context, c client.Client, doclingServe *v1alpha1.DoclingServe) (*v1alpha1.DoclingModelCache, error) {
+	cache := &v1alpha1.DoclingModelCache{}
+	if err := c.Get(ctx, types.NamespacedName{Name: modelCacheName(doclingServe), Namespace: doclingServe.Namespace}, cache); err != nil {
+		if errors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return cache, nil
+}
+
+// modelCacheReady reports whether the cache downloaded the models of its current spec.
+func modelCacheReady(cache *v1alpha1.DoclingModelCache) bool {
+	condition := meta.FindStatusCondition(cache.Status.Conditions, "Ready")
+	return condition != nil && condition.Status == metav1.ConditionTrue && condition.ObservedGeneration == cache.Generation
+}
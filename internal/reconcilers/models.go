@@ -0,0 +1,444 @@
+package reconcilers
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"path"
+
+	"github.io/docling-project/docling-operator/api/v1alpha1"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+const (
+	// modelsMountPath is where the model volume is mounted, in the download job and in the docling-serve pods.
+	modelsMountPath = "/models"
+	// modelsHashAnnotation holds the hash of the download the job runs, a different download replaces the job.
+	modelsHashAnnotation = "docling.github.io/models-hash"
+	// artifactsPathEnv points docling-serve at the directory of its models.
+	artifactsPathEnv = "DOCLING_SERVE_ARTIFACTS_PATH"
+	// modelsCopyPath is where the init container copying the models of an image mounts the emptyDir volume, apart
+	// from the directory of the models in the image.
+	modelsCopyPath = "/docling-models"
+	// imageMountModeImageVolume mounts the models image as an image volume instead of copying it.
+	imageMountModeImageVolume = "ImageVolume"
+	// modelsCopyUser is the non-root user the init container copying the models of an image runs as, unless the
+	// security context sets one.
+	modelsCopyUser = 1001
+)
+
+// ModelsReconciler provisions the model volume and runs the job downloading the models into it.
+type ModelsReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+}
+
+func NewModelsReconciler(client client.Client, scheme *runtime.Scheme) *ModelsReconciler {
+	return &ModelsReconciler{
+		Client: client,
+		Scheme: scheme,
+	}
+}
+
+func (r *ModelsReconciler) Reconcile(ctx context.Context, doclingServe *v1alpha1.DoclingServe) (bool, error) {
+	// The volume of a shared cache is managed by its DoclingModelCache, the models of an image are not downloaded.
+	if modelsEnabled(doclingServe) && modelCacheName(doclingServe) == "" && modelsImage(doclingServe) == "" {
+		return r.createOrUpdate(ctx, doclingServe)
+	}
+
+	return r.delete(ctx, doclingServe)
+}
+
+func (r *ModelsReconciler) createOrUpdate(ctx context.Context, doclingServe *v1alpha1.DoclingServe) (bool, error) {
+	models := doclingServe.Spec.Models
+
+	size := resource.MustParse("10Gi")
+	if models.Size != nil {
+		size = *models.Size
+	}
+	accessMode := models.AccessMode
+	if accessMode == "" {
+		accessMode = corev1.ReadWriteOnce
+	}
+	pvc := &corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{Name: modelsPVCName(doclingServe), Namespace: doclingServe.Namespace, Labels: labelsForDocling(doclingServe.Name)},
+		Spec: corev1.PersistentVolumeClaimSpec{
+			AccessModes:      []corev1.PersistentVolumeAccessMode{accessMode},
+			StorageClassName: models.StorageClassName,
+			Resources:        corev1.VolumeResourceRequirements{Requests: corev1.ResourceList{corev1.ResourceStorage: size}},
+		},
+	}
+	if err := ensureDownloadVolume(ctx, r.Client, r.Scheme, doclingServe, pvc); err != nil {
+		return true, err
+	}
+
+	job, err := r.downloadJob(doclingServe)
+	if err != nil {
+		return true, err
+	}
+	return ensureDownloadJob(ctx, r.Client, job)
+}
+
+// downloadJob builds the job running docling-tools models download into the model volume.
+func (r *ModelsReconciler) downloadJob(doclingServe *v1alpha1.DoclingServe) (*batchv1.Job, error) {
+	models := doclingServe.Spec.Models
+	podSecurity, err := podSecurityContext(doclingServe)
+	if err != nil {
+		return nil, err
+	}
+	containerSecurity, err := containerSecurityContext(doclingServe)
+	if err != nil {
+		return nil, err
+	}
+	hash, err := modelsHash(doclingServe)
+	if err != nil {
+		return nil, err
+	}
+
+	args := []string{"models", "download", "--output-dir", modelsMountPath}
+	args = append(args, models.Bundles...)
+	container := corev1.Container{
+		Name:            "download",
+		Image:           doclingServe.Spec.APIServer.Image,
+		Command:         []string{"docling-tools"},
+		Args:            args,
+		ImagePullPolicy: corev1.PullIfNotPresent,
+		SecurityContext: containerSecurity,
+		VolumeMounts: []corev1.VolumeMount{{
+			Name:      "models",
+			MountPath: modelsMountPath,
+		}},
+	}
+	volumes := []corev1.Volume{{
+		Name: "models",
+		VolumeSource: corev1.VolumeSource{
+			PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: modelsPVCName(doclingServe)},
+		},
+	}}
+	if readOnlyRootFilesystem(containerSecurity) {
+		writable, mounts := writableVolumes()
+		container.Env = append(container.Env, corev1.EnvVar{Name: "XDG_CACHE_HOME", Value: cacheMountPath})
+		container.VolumeMounts = append(container.VolumeMounts, mounts...)
+		volumes = append(volumes, writable...)
+	}
+	if models.HFTokenSecretRef != nil {
+		container.Env = append(container.Env, corev1.EnvVar{
+			Name:      "HF_TOKEN",
+			ValueFrom: &corev1.EnvVarSource{SecretKeyRef: models.HFTokenSecretRef},
+		})
+	}
+
+	labels := labelsForModelsDownload(doclingServe.Name)
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        modelsJobName(doclingServe),
+			Namespace:   doclingServe.Namespace,
+			Labels:      labels,
+			Annotations: map[string]string{modelsHashAnnotation: hash},
+		},
+		Spec: batchv1.JobSpec{
+			BackoffLimit: ptr.To(int32(3)),
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: labels},
+				Spec: corev1.PodSpec{
+					RestartPolicy:      corev1.RestartPolicyOnFailure,
+					ServiceAccountName: serviceAccountName(doclingServe),
+					SecurityContext:    podSecurity,
+					Containers:         []corev1.Container{container},
+					Volumes:            volumes,
+				},
+			},
+		},
+	}
+	// The volume may only be attachable to the nodes the docling-serve pods run on, so the job runs on them as well,
+	// without the constraints spreading the docling-serve pods, and next to them with a ReadWriteOnce volume.
+	applyScheduling(doclingServe, &job.Spec.Template.Spec)
+	job.Spec.Template.Spec.Affinity = doclingServe.Spec.APIServer.Affinity
+	job.Spec.Template.Spec.TopologySpreadConstraints = nil
+	colocateWithModels(doclingServe, &job.Spec.Template.Spec)
+	_ = ctrl.SetControllerReference(doclingServe, job, r.Scheme)
+	return job, nil
+}
+
+func (r *ModelsReconciler) delete(ctx context.Context, doclingServe *v1alpha1.DoclingServe) (bool, error) {
+	log := logf.FromContext(ctx)
+	job := &batchv1.Job{}
+	if err := r.Get(ctx, types.NamespacedName{Name: modelsJobName(doclingServe), Namespace: doclingServe.Namespace}, job); err != nil && !errors.IsNotFound(err) {
+		log.Error(err, "Error deleting models download Job", "Job.Namespace", doclingServe.Namespace, "Job.Name", modelsJobName(doclingServe))
+		return true, err
+	} else if err == nil {
+		if err := r.Delete(ctx, job, client.PropagationPolicy(metav1.DeletePropagationBackground)); err != nil && !errors.IsNotFound(err) {
+			log.Error(err, "Error deleting models download Job", "Job.Namespace", job.Namespace, "Job.Name", job.Name)
+			return true, err
+		}
+		log.Info("Successfully deleted models download Job", "Job.Namespace", job.Namespace, "Job.Name", job.Name)
+	}
+
+	pvc := &corev1.PersistentVolumeClaim{}
+	if err := r.Get(ctx, types.NamespacedName{Name: modelsPVCName(doclingServe), Namespace: doclingServe.Namespace}, pvc); err != nil && !errors.IsNotFound(err) {
+		log.Error(err, "Error deleting PersistentVolumeClaim", "PersistentVolumeClaim.Namespace", doclingServe.Namespace, "PersistentVolumeClaim.Name", modelsPVCName(doclingServe))
+		return true, err
+	} else if errors.IsNotFound(err) {
+		return false, nil
+	}
+
+	if err := r.Delete(ctx, pvc); err != nil && !errors.IsNotFound(err) {
+		log.Error(err, "Error deleting PersistentVolumeClaim", "PersistentVolumeClaim.Namespace", pvc.Namespace, "PersistentVolumeClaim.Name", pvc.Name)
+		return true, err
+	}
+
+	log.Info("Successfully deleted PersistentVolumeClaim", "PersistentVolumeClaim.Namespace", pvc.Namespace, "PersistentVolumeClaim.Name", pvc.Name)
+	return false, nil
+}
+
+// ensureDownloadVolume creates the volume the models are downloaded into, owned by the owner. Only the requested size of
+// a bound claim can change, and only to grow.
+func ensureDownloadVolume(ctx context.Context, c client.Client, scheme *runtime.Scheme, owner client.Object, desired *corev1.PersistentVolumeClaim) error {
+	log := logf.FromContext(ctx)
+
+	pvc := &corev1.PersistentVolumeClaim{ObjectMeta: metav1.ObjectMeta{Name: desired.Name, Namespace: desired.Namespace}}
+	_, err := controllerutil.CreateOrUpdate(ctx, c, pvc, func() error {
+		pvc.Labels = desired.Labels
+		size := desired.Spec.Resources.Requests[corev1.ResourceStorage]
+		if pvc.CreationTimestamp.IsZero() {
+			pvc.Spec = desired.Spec
+		} else if current := pvc.Spec.Resources.Requests[corev1.ResourceStorage]; size.Cmp(current) > 0 {
+			pvc.Spec.Resources.Requests[corev1.ResourceStorage] = size
+		}
+		_ = ctrl.SetControllerReference(owner, pvc, scheme)
+		return nil
+	})
+	if err != nil {
+		log.Error(err, "Error creating/updating PersistentVolumeClaim", "PersistentVolumeClaim.Namespace", pvc.Namespace, "PersistentVolumeClaim.Name", pvc.Name)
+		return err
+	}
+	log.Info("Successfully created/updated PersistentVolumeClaim", "PersistentVolumeClaim.Namespace", pvc.Namespace, "PersistentVolumeClaim.Name", pvc.Name)
+	return nil
+}
+
+// ensureDownloadJob creates the download job. The template of a job cannot change, so a job running a different
+// download, as told by its hash annotation, is deleted and the reconciliation requeued to create it again once it is
+// gone.
+func ensureDownloadJob(ctx context.Context, c client.Client, job *batchv1.Job) (bool, error) {
+	log := logf.FromContext(ctx)
+
+	existing := &batchv1.Job{}
+	err := c.Get(ctx, types.NamespacedName{Name: job.Name, Namespace: job.Namespace}, existing)
+	if err != nil && !errors.IsNotFound(err) {
+		log.Error(err, "Error getting download Job", "Job.Namespace", job.Namespace, "Job.Name", job.Name)
+		return true, err
+	}
+	if err == nil {
+		if existing.Annotations[modelsHashAnnotation] == job.Annotations[modelsHashAnnotation] {
+			return false, nil
+		}
+		if err := c.Delete(ctx, existing, client.PropagationPolicy(metav1.DeletePropagationBackground)); err != nil && !errors.IsNotFound(err) {
+			log.Error(err, "Error deleting download Job", "Job.Namespace", existing.Namespace, "Job.Name", existing.Name)
+			return true, err
+		}
+		log.Info("Successfully deleted outdated download Job", "Job.Namespace", existing.Namespace, "Job.Name", existing.Name)
+		return true, nil
+	}
+
+	if err := c.Create(ctx, job); err != nil {
+		log.Error(err, "Error creating download Job", "Job.Namespace", job.Namespace, "Job.Name", job.Name)
+		return true, err
+	}
+	log.Info("Successfully created download Job", "Job.Namespace", job.Namespace, "Job.Name", job.Name)
+	return false, nil
+}
+
+func modelsEnabled(doclingServe *v1alpha1.DoclingServe) bool {
+	return doclingServe.Spec.Models != nil && doclingServe.Spec.Models.Enabled
+}
+
+// modelCacheName returns the name of the DoclingModelCache the DoclingServe mounts, or an empty string when its models
+// are downloaded in a dedicated volume.
+func modelCacheName(doclingServe *v1alpha1.DoclingServe) string {
+	if !modelsEnabled(doclingServe) {
+		return ""
+	}
+	return doclingServe.Spec.Models.CacheName
+}
+
+// modelsImage returns the image holding the models of the DoclingServe, or an empty string when they are downloaded.
+func modelsImage(doclingServe *v1alpha1.DoclingServe) string {
+	if !modelsEnabled(doclingServe) {
+		return ""
+	}
+	return doclingServe.Spec.Models.Image
+}
+
+// labelsForModelsDownload labels the download job pods, they are not selected by the docling-serve service and
+// network policy.
+func labelsForModelsDownload(name string) map[string]string {
+	return map[string]string{"app": "docling-serve-models", "doclingserve_cr": name}
+}
+
+func modelsPVCName(doclingServe *v1alpha1.DoclingServe) string {
+	return doclingServe.Name + "-models"
+}
+
+func modelsJobName(doclingServe *v1alpha1.DoclingServe) string {
+	return doclingServe.Name + "-models-download"
+}
+
+// modelsHash identifies the download of the DoclingServe models: the bundles and the token. The docling-serve image
+// only provides docling-tools, upgrading it does not download the models again.
+func modelsHash(doclingServe *v1alpha1.DoclingServe) (string, error) {
+	return downloadHash(struct {
+		Bundles          []string
+		HFTokenSecretRef *corev1.SecretKeySelector
+	}{doclingServe.Spec.Models.Bundles, doclingServe.Spec.Models.HFTokenSecretRef})
+}
+
+// downloadHash hashes the fields identifying a download.
+func downloadHash(download any) (string, error) {
+	data, err := json.Marshal(download)
+	if err != nil {
+		return "", err
+	}
+	hash := sha256.Sum256(data)
+	return hex.EncodeToString(hash[:8]), nil
+}
+
+// modelsReady reports whether the models mounted by the DoclingServe are downloaded, in its dedicated volume or in the
+// DoclingModelCache it references.
+func modelsReady(ctx context.Context, c client.Client, doclingServe *v1alpha1.DoclingServe) (bool, error) {
+	// The kubelet pulls the models image with the pods.
+	if modelsImage(doclingServe) != "" {
+		return true, nil
+	}
+	if modelCacheName(doclingServe) != "" {
+		cache, err := modelCache(ctx, c, doclingServe)
+		return cache != nil && modelCacheReady(cache), err
+	}
+	downloaded, _, err := modelsDownloaded(ctx, c, doclingServe)
+	return downloaded, err
+}
+
+// modelsPending reports whether the creation of the named Deployment waits for the models. An existing Deployment keeps
+// being updated while the models are downloaded again, its pods already mount them.
+func modelsPending(ctx context.Context, c client.Client, doclingServe *v1alpha1.DoclingServe, name string) (bool, error) {
+	if !modelsEnabled(doclingServe) {
+		return false, nil
+	}
+	ready, err := modelsReady(ctx, c, doclingServe)
+	if err != nil || ready {
+		return false, err
+	}
+	err = c.Get(ctx, types.NamespacedName{Name: name, Namespace: doclingServe.Namespace}, &appsv1.Deployment{})
+	if errors.IsNotFound(err) {
+		return true, nil
+	}
+	return false, err
+}
+
+// modelsDownloaded reports whether the download job of the current models completed. It returns the job, or nil when
+// it does not exist yet.
+func modelsDownloaded(ctx context.Context, c client.Client, doclingServe *v1alpha1.DoclingServe) (bool, *batchv1.Job, error) {
+	job := &batchv1.Job{}
+	if err := c.Get(ctx, types.NamespacedName{Name: modelsJobName(doclingServe), Namespace: doclingServe.Namespace}, job); err != nil {
+		if errors.IsNotFound(err) {
+			return false, nil, nil
+		}
+		return false, nil, err
+	}
+	hash, err := modelsHash(doclingServe)
+	if err != nil {
+		return false, nil, err
+	}
+	if job.Annotations[modelsHashAnnotation] != hash {
+		return false, nil, nil
+	}
+	return jobConditionTrue(job, batchv1.JobComplete), job, nil
+}
+
+func jobConditionTrue(job *batchv1.Job, conditionType batchv1.JobConditionType) bool {
+	for _, condition := range job.Status.Conditions {
+		if condition.Type == conditionType && condition.Status == corev1.ConditionTrue {
+			return true
+		}
+	}
+	return false
+}
+
+// modelsVolume returns the volume and the mount of the models in the docling-serve pods, with the directory docling-serve
+// reads them from.
+func modelsVolume(doclingServe *v1alpha1.DoclingServe) (corev1.Volume, corev1.VolumeMount, string) {
+	mount := corev1.VolumeMount{Name: "models", MountPath: modelsMountPath, ReadOnly: true}
+	if image := modelsImage(doclingServe); image != "" {
+		models := doclingServe.Spec.Models
+		if models.ImageMountMode == imageMountModeImageVolume {
+			volume := corev1.Volume{
+				Name: "models",
+				VolumeSource: corev1.VolumeSource{
+					Image: &corev1.ImageVolumeSource{Reference: image, PullPolicy: corev1.PullIfNotPresent},
+				},
+			}
+			// The whole image is mounted, the models are in its directory.
+			return volume, mount, path.Join(modelsMountPath, modelsImagePath(doclingServe))
+		}
+		volume := corev1.Volume{Name: "models", VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}}}
+		return volume, mount, modelsMountPath
+	}
+
+	claimName := modelsPVCName(doclingServe)
+	if cacheName := modelCacheName(doclingServe); cacheName != "" {
+		claimName = modelCachePVCName(cacheName)
+	}
+	volume := corev1.Volume{
+		Name: "models",
+		VolumeSource: corev1.VolumeSource{
+			PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: claimName, ReadOnly: true},
+		},
+	}
+	return volume, mount, modelsMountPath
+}
+
+// modelsInitContainer returns the init container copying the models of the image into the models volume, or nil when
+// the models are not copied.
+func modelsInitContainer(doclingServe *v1alpha1.DoclingServe, podSecurity *corev1.PodSecurityContext, securityContext *corev1.SecurityContext, capabilities Capabilities) *corev1.Container {
+	image := modelsImage(doclingServe)
+	if image == "" || doclingServe.Spec.Models.ImageMountMode == imageMountModeImageVolume {
+		return nil
+	}
+	// Models images copied with cp are usually built from busybox or a similar base running as root, which
+	// runAsNonRoot refuses to start without a user. The security context constraints of OpenShift assign one of the namespace range, and reject
+	// any other.
+	if !capabilities.SecurityContextConstraints && securityContext.RunAsUser == nil && (podSecurity == nil || podSecurity.RunAsUser == nil) {
+		securityContext = securityContext.DeepCopy()
+		securityContext.RunAsUser = ptr.To(int64(modelsCopyUser))
+	}
+	return &corev1.Container{
+		Name:            "models",
+		Image:           image,
+		Command:         []string{"cp", "-R", modelsImagePath(doclingServe) + "/.", modelsCopyPath},
+		ImagePullPolicy: corev1.PullIfNotPresent,
+		SecurityContext: securityContext,
+		VolumeMounts: []corev1.VolumeMount{{
+			Name:      "models",
+			MountPath: modelsCopyPath,
+		}},
+	}
+}
+
+func modelsImagePath(doclingServe *v1alpha1.DoclingServe) string {
+	if doclingServe.Spec.Models.ImagePath == "" {
+		return modelsMountPath
+	}
+	return doclingServe.Spec.Models.ImagePath
+}
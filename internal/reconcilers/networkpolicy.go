@@ -0,0 +1,261 @@
+package reconcilers
+
+import (
+	"context"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.io/docling-project/docling-operator/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+const (
+	networkPolicyModeNone                = "none"
+	networkPolicyModeRestrictToNamespace = "restrictToNamespace"
+	networkPolicyModeAllowFrom           = "allowFrom"
+	// openShiftIngressPolicyGroupLabel labels the namespaces of the OpenShift routers.
+	openShiftIngressPolicyGroupLabel = "policy-group.network.openshift.io/ingress"
+)
+
+type NetworkPolicyReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+}
+
+func NewNetworkPolicyReconciler(client client.Client, scheme *runtime.Scheme) *NetworkPolicyReconciler {
+	return &NetworkPolicyReconciler{
+		Client: client,
+		Scheme: scheme,
+	}
+}
+
+func (r *NetworkPolicyReconciler) Reconcile(ctx context.Context, doclingServe *v1alpha1.DoclingServe) (bool, error) {
+	if networkPolicyMode(doclingServe) != networkPolicyModeNone {
+		return r.createOrUpdate(ctx, doclingServe)
+	}
+
+	return r.delete(ctx, doclingServe)
+}
+
+func (r *NetworkPolicyReconciler) createOrUpdate(ctx context.Context, doclingServe *v1alpha1.DoclingServe) (bool, error) {
+	log := logf.FromContext(ctx)
+	spec := doclingServe.Spec.NetworkPolicy
+	networkPolicy := &networkingv1.NetworkPolicy{ObjectMeta: metav1.ObjectMeta{Name: doclingServe.Name + "-networkpolicy", Namespace: doclingServe.Namespace}}
+	_, err := controllerutil.CreateOrUpdate(ctx, r.Client, networkPolicy, func() error {
+		labels := labelsForDocling(doclingServe.Name)
+		networkPolicy.Labels = labels
+
+		port := intstr.FromInt32(doclingServePort)
+		if oauthProxyEnabled(doclingServe) {
+			port = intstr.FromInt32(oauthProxyPort)
+		}
+		from := []networkingv1.NetworkPolicyPeer{{PodSelector: &metav1.LabelSelector{}}}
+		if selector := ingressControllerNamespaceSelector(doclingServe); selector != nil {
+			from = append(from, networkingv1.NetworkPolicyPeer{NamespaceSelector: selector})
+		}
+		if networkPolicyMode(doclingServe) == networkPolicyModeAllowFrom {
+			from = append(from, spec.From...)
+		}
+
+		networkPolicy.Spec = networkingv1.NetworkPolicySpec{
+			PodSelector: networkPolicyPodSelector(doclingServe),
+			Ingress: []networkingv1.NetworkPolicyIngressRule{
+				{
+					Ports: []networkingv1.NetworkPolicyPort{{Protocol: ptr.To(corev1.ProtocolTCP), Port: &port}},
+					From:  from,
+				},
+			},
+			PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress},
+		}
+		if spec.RestrictEgress {
+			networkPolicy.Spec.Egress = egressRules(doclingServe)
+			networkPolicy.Spec.PolicyTypes = append(networkPolicy.Spec.PolicyTypes, networkingv1.PolicyTypeEgress)
+		}
+
+		_ = ctrl.SetControllerReference(doclingServe, networkPolicy, r.Scheme)
+		return nil
+	})
+	if err != nil {
+		log.Error(err, "Error creating/updating NetworkPolicy", "NetworkPolicy.Namespace", networkPolicy.Namespace, "NetworkPolicy.Name", networkPolicy.Name)
+		return true, err
+	}
+
+	log.Info("Successfully created/updated NetworkPolicy", "NetworkPolicy.Namespace", networkPolicy.Namespace, "NetworkPolicy.Name", networkPolicy.Name)
+	return false, nil
+}
+
+func (r *NetworkPolicyReconciler) delete(ctx context.Context, doclingServe *v1alpha1.DoclingServe) (bool, error) {
+	log := logf.FromContext(ctx)
+	networkPolicy := &networkingv1.NetworkPolicy{}
+	if err := r.Get(ctx, types.NamespacedName{Name: doclingServe.Name + "-networkpolicy", Namespace: doclingServe.Namespace}, networkPolicy); err != nil && !errors.IsNotFound(err) {
+		log.Error(err, "Error deleting NetworkPolicy", "NetworkPolicy.Namespace", doclingServe.Namespace, "NetworkPolicy.Name", doclingServe.Name+"-networkpolicy")
+		return true, err
+	} else if errors.IsNotFound(err) {
+		return false, nil
+	}
+
+	if err := r.Delete(ctx, networkPolicy); err != nil {
+		log.Error(err, "Error deleting NetworkPolicy", "NetworkPolicy.Namespace", networkPolicy.Namespace, "NetworkPolicy.Name", networkPolicy.Name)
+		return true, err
+	}
+
+	log.Info("Successfully deleted NetworkPolicy", "NetworkPolicy.Namespace", networkPolicy.Namespace, "NetworkPolicy.Name", networkPolicy.Name)
+	return false, nil
+}
+
+func networkPolicyMode(doclingServe *v1alpha1.DoclingServe) string {
+	if doclingServe.Spec.NetworkPolicy == nil || doclingServe.Spec.NetworkPolicy.Mode == "" {
+		return networkPolicyModeNone
+	}
+	return doclingServe.Spec.NetworkPolicy.Mode
+}
+
+// ingressControllerNamespaceSelector returns the selector of the namespaces the route, ingress or gateway traffic comes from.
+func ingressControllerNamespaceSelector(doclingServe *v1alpha1.DoclingServe) *metav1.LabelSelector {
+	if doclingServe.Spec.NetworkPolicy.IngressControllerNamespaceSelector != nil {
+		return doclingServe.Spec.NetworkPolicy.IngressControllerNamespaceSelector
+	}
+	if doclingServe.Spec.Route != nil && doclingServe.Spec.Route.Enabled {
+		return &metav1.LabelSelector{MatchLabels: map[string]string{openShiftIngressPolicyGroupLabel: ""}}
+	}
+	return nil
+}
+
+// networkPolicyPodSelector selects the docling-serve pods, and the RQ workers which fetch the documents and share
+// their egress rules.
+func networkPolicyPodSelector(doclingServe *v1alpha1.DoclingServe) metav1.LabelSelector {
+	if rqEngine(doclingServe) == nil {
+		return metav1.LabelSelector{MatchLabels: labelsForDocling(doclingServe.Name)}
+	}
+	return metav1.LabelSelector{
+		MatchLabels: map[string]string{"doclingserve_cr": doclingServe.Name},
+		MatchExpressions: []metav1.LabelSelectorRequirement{{
+			Key:      "app",
+			Operator: metav1.LabelSelectorOpIn,
+			Values:   []string{labelsForDocling(doclingServe.Name)["app"], labelsForWorker(doclingServe.Name)["app"]},
+		}},
+	}
+}
+
+// egressRules returns the connections docling-serve and its workers need to open when their egress is restricted.
+func egressRules(doclingServe *v1alpha1.DoclingServe) []networkingv1.NetworkPolicyEgressRule {
+	spec := doclingServe.Spec.NetworkPolicy
+	dnsPort := intstr.FromInt32(53)
+	// The OpenShift DNS pods listen on 5353 behind the 53 service port.
+	openShiftDNSPort := intstr.FromInt32(5353)
+	rules := []networkingv1.NetworkPolicyEgressRule{
+		{
+			To: []networkingv1.NetworkPolicyPeer{{NamespaceSelector: &metav1.LabelSelector{}}},
+			Ports: []networkingv1.NetworkPolicyPort{
+				{Protocol: ptr.To(corev1.ProtocolUDP), Port: &dnsPort},
+				{Protocol: ptr.To(corev1.ProtocolTCP), Port: &dnsPort},
+				{Protocol: ptr.To(corev1.ProtocolUDP), Port: &openShiftDNSPort},
+				{Protocol: ptr.To(corev1.ProtocolTCP), Port: &openShiftDNSPort},
+			},
+		},
+	}
+
+	if doclingServe.Spec.Engine.KFP != nil {
+		if rule, ok := endpointEgressRule(doclingServe.Spec.Engine.KFP.Endpoint); ok {
+			rules = append(rules, rule)
+		}
+	}
+
+	// The RQ engine queues the tasks in Redis. A URL read from a secret is unknown here, its egress is left to the
+	// user rules.
+	if rq := rqEngine(doclingServe); rq != nil {
+		switch {
+		case rq.Redis.Managed != nil:
+			port := intstr.FromInt32(redisPort)
+			rules = append(rules, networkingv1.NetworkPolicyEgressRule{
+				To:    []networkingv1.NetworkPolicyPeer{{PodSelector: &metav1.LabelSelector{MatchLabels: labelsForRedis(doclingServe.Name)}}},
+				Ports: []networkingv1.NetworkPolicyPort{{Protocol: ptr.To(corev1.ProtocolTCP), Port: &port}},
+			})
+		case rq.Redis.URL != "":
+			if rule, ok := endpointEgressRule(rq.Redis.URL); ok {
+				rules = append(rules, rule)
+			}
+		}
+	}
+
+	// The OAuth proxy authenticates the users and their access against the API server.
+	if oauthProxyEnabled(doclingServe) {
+		httpsPort := intstr.FromInt32(443)
+		apiServerPort := intstr.FromInt32(6443)
+		rules = append(rules, networkingv1.NetworkPolicyEgressRule{
+			Ports: []networkingv1.NetworkPolicyPort{
+				{Protocol: ptr.To(corev1.ProtocolTCP), Port: &httpsPort},
+				{Protocol: ptr.To(corev1.ProtocolTCP), Port: &apiServerPort},
+			},
+		})
+	}
+
+	if spec.AllowModelDownloads {
+		httpsPort := intstr.FromInt32(443)
+		rules = append(rules, networkingv1.NetworkPolicyEgressRule{
+			To: []networkingv1.NetworkPolicyPeer{
+				{IPBlock: &networkingv1.IPBlock{CIDR: "0.0.0.0/0"}},
+				{IPBlock: &networkingv1.IPBlock{CIDR: "::/0"}},
+			},
+			Ports: []networkingv1.NetworkPolicyPort{{Protocol: ptr.To(corev1.ProtocolTCP), Port: &httpsPort}},
+		})
+	}
+
+	return append(rules, spec.Egress...)
+}
+
+// endpointEgressRule allows the connections to an http(s) or redis(s) endpoint. Network policies cannot match host names, so
+// services of the cluster are matched by namespace, IP addresses by themselves and other hosts only by port.
+func endpointEgressRule(endpoint string) (networkingv1.NetworkPolicyEgressRule, bool) {
+	parsed, err := url.Parse(endpoint)
+	if err != nil || parsed.Hostname() == "" {
+		return networkingv1.NetworkPolicyEgressRule{}, false
+	}
+
+	port := 443
+	switch parsed.Scheme {
+	case "http":
+		port = 80
+	case "redis", "rediss":
+		port = redisPort
+	}
+	if parsed.Port() != "" {
+		if port, err = strconv.Atoi(parsed.Port()); err != nil {
+			return networkingv1.NetworkPolicyEgressRule{}, false
+		}
+	}
+	targetPort := intstr.FromInt(port)
+	rule := networkingv1.NetworkPolicyEgressRule{
+		Ports: []networkingv1.NetworkPolicyPort{{Protocol: ptr.To(corev1.ProtocolTCP), Port: &targetPort}},
+	}
+
+	host := parsed.Hostname()
+	if ip := net.ParseIP(host); ip != nil {
+		cidr := host + "/32"
+		if ip.To4() == nil {
+			cidr = host + "/128"
+		}
+		rule.To = []networkingv1.NetworkPolicyPeer{{IPBlock: &networkingv1.IPBlock{CIDR: cidr}}}
+		return rule, true
+	}
+	labels := strings.Split(strings.TrimSuffix(host, ".cluster.local"), ".")
+	if len(labels) == 3 && labels[2] == "svc" {
+		rule.To = []networkingv1.NetworkPolicyPeer{{
+			NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{corev1.LabelMetadataName: labels[1]}},
+		}}
+	}
+	return rule, true
+}
@@ -0,0 +1,210 @@
+package reconcilers
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	"github.io/docling-project/docling-operator/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+const (
+	// oauthProxyPort is the port the OAuth proxy serves HTTPS on.
+	oauthProxyPort = 8443
+	// oauthProxyPortName names the OAuth proxy port in the pod and in the service.
+	oauthProxyPortName = "https"
+	// oauthProxyCookieSecretKey is the key of the generated secret holding the session cookie secret.
+	oauthProxyCookieSecretKey = "session_secret"
+	// servingCertSecretAnnotation asks the OpenShift service CA to issue a serving certificate for the service.
+	servingCertSecretAnnotation = "service.beta.openshift.io/serving-cert-secret-name"
+	// oauthRedirectReferenceAnnotation lets the OAuth server redirect users of the service account client back to the route.
+	oauthRedirectReferenceAnnotation = "serviceaccounts.openshift.io/oauth-redirectreference.primary"
+)
+
+type OAuthProxySecretReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+}
+
+func NewOAuthProxySecretReconciler(client client.Client, scheme *runtime.Scheme) *OAuthProxySecretReconciler {
+	return &OAuthProxySecretReconciler{
+		Client: client,
+		Scheme: scheme,
+	}
+}
+
+func (r *OAuthProxySecretReconciler) Reconcile(ctx context.Context, doclingServe *v1alpha1.DoclingServe) (bool, error) {
+	if oauthProxyEnabled(doclingServe) {
+		return r.createOrUpdate(ctx, doclingServe)
+	}
+
+	return r.delete(ctx, doclingServe)
+}
+
+func (r *OAuthProxySecretReconciler) createOrUpdate(ctx context.Context, doclingServe *v1alpha1.DoclingServe) (bool, error) {
+	log := logf.FromContext(ctx)
+	secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: doclingServe.Name + "-oauth-proxy", Namespace: doclingServe.Namespace}}
+	_, err := controllerutil.CreateOrUpdate(ctx, r.Client, secret, func() error {
+		secret.Labels = labelsForDocling(doclingServe.Name)
+		secret.Type = corev1.SecretTypeOpaque
+		// The cookie secret is kept as long as the secret exists, regenerating it logs every user out.
+		if len(secret.Data[oauthProxyCookieSecretKey]) == 0 {
+			buf := make([]byte, 16)
+			if _, err := rand.Read(buf); err != nil {
+				return err
+			}
+			secret.Data = map[string][]byte{oauthProxyCookieSecretKey: []byte(hex.EncodeToString(buf))}
+		}
+		_ = ctrl.SetControllerReference(doclingServe, secret, r.Scheme)
+		return nil
+	})
+	if err != nil {
+		log.Error(err, "Error creating OAuth proxy Secret", "Secret.Namespace", secret.Namespace, "Secret.Name", secret.Name)
+		return true, err
+	}
+
+	log.Info("Successfully created OAuth proxy Secret", "Secret.Namespace", secret.Namespace, "Secret.Name", secret.Name)
+	return false, nil
+}
+
+func (r *OAuthProxySecretReconciler) delete(ctx context.Context, doclingServe *v1alpha1.DoclingServe) (bool, error) {
+	log := logf.FromContext(ctx)
+	secret := &corev1.Secret{}
+	err := r.Get(ctx, types.NamespacedName{Name: doclingServe.Name + "-oauth-proxy", Namespace: doclingServe.Namespace}, secret)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return false, nil
+		}
+		log.Error(err, "Error getting OAuth proxy Secret", "Secret.Namespace", doclingServe.Namespace, "Secret.Name", doclingServe.Name+"-oauth-proxy")
+		return true, err
+	}
+
+	if !metav1.IsControlledBy(secret, doclingServe) {
+		return false, nil
+	}
+
+	if err := r.Delete(ctx, secret); err != nil && !errors.IsNotFound(err) {
+		log.Error(err, "Error deleting OAuth proxy Secret", "Secret.Namespace", secret.Namespace, "Secret.Name", secret.Name)
+		return true, err
+	}
+
+	log.Info("Successfully deleted OAuth proxy Secret", "Secret.Namespace", secret.Namespace, "Secret.Name", secret.Name)
+	return false, nil
+}
+
+func oauthProxyEnabled(doclingServe *v1alpha1.DoclingServe) bool {
+	return doclingServe.Spec.APIServer.OAuthProxy != nil && doclingServe.Spec.APIServer.OAuthProxy.Enabled
+}
+
+// oauthProxyTLSSecretName is the secret the OpenShift service CA issues the serving certificate of the proxy in.
+func oauthProxyTLSSecretName(doclingServe *v1alpha1.DoclingServe) string {
+	return doclingServe.Name + "-proxy-tls"
+}
+
+// oauthRedirectReference points the OAuth server of the service account client at the route of the DoclingServe.
+func oauthRedirectReference(doclingServe *v1alpha1.DoclingServe) string {
+	return fmt.Sprintf(`{"kind":"OAuthRedirectReference","apiVersion":"v1","reference":{"kind":"Route","name":"%s"}}`, doclingServe.Name+"-route")
+}
+
+// oauthProxyContainer builds the sidecar authenticating the users against OpenShift and proxying them to docling-serve.
+func oauthProxyContainer(doclingServe *v1alpha1.DoclingServe) (corev1.Container, error) {
+	spec := doclingServe.Spec.APIServer.OAuthProxy
+	sar, err := json.Marshal(map[string]string{
+		"namespace": doclingServe.Namespace,
+		"group":     v1alpha1.GroupVersion.Group,
+		"resource":  "doclingserves",
+		"name":      doclingServe.Name,
+		"verb":      "get",
+	})
+	if err != nil {
+		return corev1.Container{}, err
+	}
+
+	image := spec.Image
+	if image == "" {
+		image = v1alpha1.DefaultOAuthProxyImage
+	}
+	container := corev1.Container{
+		Name:  "oauth-proxy",
+		Image: image,
+		Args: []string{
+			"--provider=openshift",
+			fmt.Sprintf("--https-address=:%d", oauthProxyPort),
+			fmt.Sprintf("--upstream=http://localhost:%d", doclingServePort),
+			"--openshift-service-account=" + serviceAccountName(doclingServe),
+			"--openshift-sar=" + string(sar),
+			"--tls-cert=/etc/tls/private/tls.crt",
+			"--tls-key=/etc/tls/private/tls.key",
+			"--cookie-secret-file=/etc/proxy/secrets/" + oauthProxyCookieSecretKey,
+		},
+		Ports: []corev1.ContainerPort{
+			{
+				ContainerPort: oauthProxyPort,
+				Name:          oauthProxyPortName,
+				Protocol:      corev1.ProtocolTCP,
+			},
+		},
+		ImagePullPolicy: corev1.PullIfNotPresent,
+		SecurityContext: defaultSecurityContext(),
+		ReadinessProbe: &corev1.Probe{
+			ProbeHandler: corev1.ProbeHandler{
+				HTTPGet: &corev1.HTTPGetAction{
+					Path:   "/oauth/healthz",
+					Port:   intstr.FromString(oauthProxyPortName),
+					Scheme: corev1.URISchemeHTTPS,
+				},
+			},
+			InitialDelaySeconds: 5,
+			TimeoutSeconds:      1,
+			PeriodSeconds:       5,
+			SuccessThreshold:    1,
+			FailureThreshold:    3,
+		},
+		VolumeMounts: []corev1.VolumeMount{
+			{
+				Name:      "proxy-tls",
+				MountPath: "/etc/tls/private",
+				ReadOnly:  true,
+			},
+			{
+				Name:      "proxy-cookie",
+				MountPath: "/etc/proxy/secrets",
+				ReadOnly:  true,
+			},
+		},
+	}
+	if spec.Resources != nil {
+		container.Resources = *spec.Resources
+	}
+	return container, nil
+}
+
+// oauthProxyVolumes returns the volumes mounted by the OAuth proxy sidecar.
+func oauthProxyVolumes(doclingServe *v1alpha1.DoclingServe) []corev1.Volume {
+	return []corev1.Volume{
+		{
+			Name: "proxy-tls",
+			VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{SecretName: oauthProxyTLSSecretName(doclingServe)},
+			},
+		},
+		{
+			Name: "proxy-cookie",
+			VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{SecretName: doclingServe.Name + "-oauth-proxy"},
+			},
+		},
+	}
+}
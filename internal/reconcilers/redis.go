@@ -0,0 +1,266 @@
+package reconcilers
+
+import (
+	"context"
+	"fmt"
+
+	"github.io/docling-project/docling-operator/api/v1alpha1"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+const (
+	// redisPort is the port of the Redis server deployed by the operator.
+	redisPort = 6379
+	// redisPasswordKey holds the generated password in the Redis secret.
+	redisPasswordKey = "password"
+	// redisPasswordEnv passes the password to the Redis server and to the URL of the docling-serve containers.
+	redisPasswordEnv = "REDIS_PASSWORD"
+	// redisDataPath is where the Software Collections Redis images store their data.
+	redisDataPath = "/var/lib/redis/data"
+	// redisURLEnv points the RQ engine of docling-serve at its Redis server.
+	redisURLEnv = "DOCLING_SERVE_ENG_RQ_REDIS_URL"
+)
+
+// RedisReconciler deploys the Redis server of the RQ engine when it is managed by the operator: a password secret, a
+// single replica StatefulSet and its Service.
+type RedisReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+}
+
+func NewRedisReconciler(client client.Client, scheme *runtime.Scheme) *RedisReconciler {
+	return &RedisReconciler{
+		Client: client,
+		Scheme: scheme,
+	}
+}
+
+func (r *RedisReconciler) Reconcile(ctx context.Context, doclingServe *v1alpha1.DoclingServe) (bool, error) {
+	if managedRedis(doclingServe) != nil {
+		return r.createOrUpdate(ctx, doclingServe)
+	}
+
+	return r.delete(ctx, doclingServe)
+}
+
+func (r *RedisReconciler) createOrUpdate(ctx context.Context, doclingServe *v1alpha1.DoclingServe) (bool, error) {
+	log := logf.FromContext(ctx)
+	managed := managedRedis(doclingServe)
+	labels := labelsForRedis(doclingServe.Name)
+
+	secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: redisName(doclingServe), Namespace: doclingServe.Namespace}}
+	_, err := controllerutil.CreateOrUpdate(ctx, r.Client, secret, func() error {
+		secret.Labels = labels
+		secret.Type = corev1.SecretTypeOpaque
+		// The password is generated once, the URL-safe API key format fits in the Redis URL.
+		if len(secret.Data[redisPasswordKey]) == 0 {
+			password, err := generateAPIKey()
+			if err != nil {
+				return err
+			}
+			secret.Data = map[string][]byte{redisPasswordKey: []byte(password)}
+		}
+		_ = ctrl.SetControllerReference(doclingServe, secret, r.Scheme)
+		return nil
+	})
+	if err != nil {
+		log.Error(err, "Error creating Redis Secret", "Secret.Namespace", secret.Namespace, "Secret.Name", secret.Name)
+		return true, err
+	}
+	log.Info("Successfully created Redis Secret", "Secret.Namespace", secret.Namespace, "Secret.Name", secret.Name)
+
+	service := &corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: redisName(doclingServe), Namespace: doclingServe.Namespace}}
+	_, err = controllerutil.CreateOrUpdate(ctx, r.Client, service, func() error {
+		service.Labels = labels
+		service.Spec.Selector = labels
+		service.Spec.Ports = []corev1.ServicePort{{
+			Name:       "redis",
+			Port:       redisPort,
+			TargetPort: intstr.FromString("redis"),
+		}}
+		_ = ctrl.SetControllerReference(doclingServe, service, r.Scheme)
+		return nil
+	})
+	if err != nil {
+		log.Error(err, "Error reconciling Redis Service", "Service.Namespace", service.Namespace, "Service.Name", service.Name)
+		return true, err
+	}
+	log.Info("Successfully reconciled Redis Service", "Service.Namespace", service.Namespace, "Service.Name", service.Name)
+
+	statefulSet := &appsv1.StatefulSet{ObjectMeta: metav1.ObjectMeta{Name: redisName(doclingServe), Namespace: doclingServe.Namespace}}
+	_, err = controllerutil.CreateOrUpdate(ctx, r.Client, statefulSet, func() error {
+		statefulSet.Labels = labels
+		// The selector, the service name and the volume claim templates of a StatefulSet cannot change.
+		if statefulSet.CreationTimestamp.IsZero() {
+			statefulSet.Spec.Selector = &metav1.LabelSelector{MatchLabels: labels}
+			statefulSet.Spec.ServiceName = redisName(doclingServe)
+			if managed.StorageSize != nil {
+				statefulSet.Spec.VolumeClaimTemplates = []corev1.PersistentVolumeClaim{{
+					ObjectMeta: metav1.ObjectMeta{Name: "data", Labels: labels},
+					Spec: corev1.PersistentVolumeClaimSpec{
+						AccessModes:      []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+						StorageClassName: managed.StorageClassName,
+						Resources: corev1.VolumeResourceRequirements{
+							Requests: corev1.ResourceList{corev1.ResourceStorage: *managed.StorageSize},
+						},
+					},
+				}}
+			}
+		}
+		statefulSet.Spec.Replicas = ptr.To(int32(1))
+
+		container := corev1.Container{
+			Name:            "redis",
+			Image:           managed.Image,
+			ImagePullPolicy: corev1.PullIfNotPresent,
+			SecurityContext: defaultSecurityContext(),
+			Env: []corev1.EnvVar{{
+				Name: redisPasswordEnv,
+				ValueFrom: &corev1.EnvVarSource{SecretKeyRef: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: redisName(doclingServe)},
+					Key:                  redisPasswordKey,
+				}},
+			}},
+			Ports: []corev1.ContainerPort{{
+				Name:          "redis",
+				ContainerPort: redisPort,
+				Protocol:      corev1.ProtocolTCP,
+			}},
+			VolumeMounts: []corev1.VolumeMount{{Name: "data", MountPath: redisDataPath}},
+			LivenessProbe: &corev1.Probe{
+				ProbeHandler:        corev1.ProbeHandler{TCPSocket: &corev1.TCPSocketAction{Port: intstr.FromString("redis")}},
+				InitialDelaySeconds: 10,
+				PeriodSeconds:       10,
+			},
+			ReadinessProbe: &corev1.Probe{
+				ProbeHandler:  corev1.ProbeHandler{TCPSocket: &corev1.TCPSocketAction{Port: intstr.FromString("redis")}},
+				PeriodSeconds: 5,
+			},
+		}
+		if managed.Resources != nil {
+			container.Resources = *managed.Resources
+		}
+		statefulSet.Spec.Template = corev1.PodTemplateSpec{
+			ObjectMeta: metav1.ObjectMeta{Labels: labels},
+			Spec: corev1.PodSpec{
+				SecurityContext: defaultPodSecurityContext(),
+				Containers:      []corev1.Container{container},
+			},
+		}
+		// Without a persistent volume, the queued tasks only live as long as the pod.
+		if len(statefulSet.Spec.VolumeClaimTemplates) == 0 {
+			statefulSet.Spec.Template.Spec.Volumes = []corev1.Volume{{
+				Name:         "data",
+				VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}},
+			}}
+		}
+		_ = ctrl.SetControllerReference(doclingServe, statefulSet, r.Scheme)
+		return nil
+	})
+	if err != nil {
+		log.Error(err, "Error reconciling Redis StatefulSet", "StatefulSet.Namespace", statefulSet.Namespace, "StatefulSet.Name", statefulSet.Name)
+		return true, err
+	}
+	log.Info("Successfully reconciled Redis StatefulSet", "StatefulSet.Namespace", statefulSet.Namespace, "StatefulSet.Name", statefulSet.Name)
+	return false, nil
+}
+
+func (r *RedisReconciler) delete(ctx context.Context, doclingServe *v1alpha1.DoclingServe) (bool, error) {
+	log := logf.FromContext(ctx)
+	key := types.NamespacedName{Name: redisName(doclingServe), Namespace: doclingServe.Namespace}
+	objects := []struct {
+		kind string
+		obj  client.Object
+	}{
+		{"StatefulSet", &appsv1.StatefulSet{}},
+		{"Service", &corev1.Service{}},
+		{"Secret", &corev1.Secret{}},
+	}
+	for _, object := range objects {
+		kind, obj := object.kind, object.obj
+		if err := r.Get(ctx, key, obj); err != nil {
+			if errors.IsNotFound(err) {
+				continue
+			}
+			log.Error(err, "Error getting Redis "+kind, kind+".Namespace", key.Namespace, kind+".Name", key.Name)
+			return true, err
+		}
+		// An object with the derived name may belong to the user.
+		if !metav1.IsControlledBy(obj, doclingServe) {
+			continue
+		}
+		if err := r.Delete(ctx, obj); err != nil && !errors.IsNotFound(err) {
+			log.Error(err, "Error deleting Redis "+kind, kind+".Namespace", key.Namespace, kind+".Name", key.Name)
+			return true, err
+		}
+		log.Info("Successfully deleted Redis "+kind, kind+".Namespace", key.Namespace, kind+".Name", key.Name)
+	}
+	return false, nil
+}
+
+// rqEngine returns the RQ engine of the DoclingServe, or nil when it runs another engine.
+func rqEngine(doclingServe *v1alpha1.DoclingServe) *v1alpha1.RQ {
+	if doclingServe.Spec.Engine == nil {
+		return nil
+	}
+	return doclingServe.Spec.Engine.RQ
+}
+
+// managedRedis returns the Redis server to deploy for the DoclingServe, or nil when it uses none or an external one.
+func managedRedis(doclingServe *v1alpha1.DoclingServe) *v1alpha1.ManagedRedis {
+	if rq := rqEngine(doclingServe); rq != nil {
+		return rq.Redis.Managed
+	}
+	return nil
+}
+
+func redisName(doclingServe *v1alpha1.DoclingServe) string {
+	return doclingServe.Name + "-redis"
+}
+
+// labelsForRedis labels the Redis server, its pods are not selected by the docling-serve services.
+func labelsForRedis(name string) map[string]string {
+	return map[string]string{"app": "docling-serve-redis", "doclingserve_cr": name}
+}
+
+// redisEnv returns the variables pointing the RQ engine at its Redis server. The URL of the managed server
+// embeds the generated password through a dependent variable.
+func redisEnv(doclingServe *v1alpha1.DoclingServe) []corev1.EnvVar {
+	redis := rqEngine(doclingServe).Redis
+	switch {
+	case redis.URLSecretRef != nil:
+		return []corev1.EnvVar{{
+			Name:      redisURLEnv,
+			ValueFrom: &corev1.EnvVarSource{SecretKeyRef: redis.URLSecretRef},
+		}}
+	case redis.Managed != nil:
+		return []corev1.EnvVar{
+			{
+				Name: redisPasswordEnv,
+				ValueFrom: &corev1.EnvVarSource{SecretKeyRef: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: redisName(doclingServe)},
+					Key:                  redisPasswordKey,
+				}},
+			},
+			{
+				Name:  redisURLEnv,
+				Value: fmt.Sprintf("redis://:$(%s)@%s:%d/0", redisPasswordEnv, redisName(doclingServe), redisPort),
+			},
+		}
+	}
+	return []corev1.EnvVar{{
+		Name:  redisURLEnv,
+		Value: redis.URL,
+	}}
+}
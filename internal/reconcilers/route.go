@@ -2,9 +2,12 @@ package reconcilers
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	routev1 "github.com/openshift/api/route/v1"
 	"github.io/docling-project/docling-operator/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -16,6 +19,17 @@ import (
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 )
 
+const (
+	// routeCACertificateKey is the key of the route TLS Secret holding the CA chain of the route certificate.
+	routeCACertificateKey = "ca.crt"
+	// routeDestinationCAKey is the key of the route TLS Secret holding the CA used to validate the pod certificate.
+	routeDestinationCAKey = "destination-ca.crt"
+	// routeTimeoutAnnotation configures the server timeout of the OpenShift router for a route.
+	routeTimeoutAnnotation = "haproxy.router.openshift.io/timeout"
+	// routeTimeoutMargin leaves docling-serve time to answer a synchronous request before the router gives up.
+	routeTimeoutMargin = 10 * time.Second
+)
+
 type RouteReconciler struct {
 	client.Client
 	Scheme *runtime.Scheme
@@ -38,23 +52,105 @@ func (r *RouteReconciler) Reconcile(ctx context.Context, doclingServe *v1alpha1.
 
 func (r *RouteReconciler) createOrUpdate(ctx context.Context, doclingServe *v1alpha1.DoclingServe) (bool, error) {
 	log := logf.FromContext(ctx)
+	spec := doclingServe.Spec.Route
+
+	var tlsSecret *corev1.Secret
+	if spec.TLSSecretName != "" {
+		tlsSecret = &corev1.Secret{}
+		if err := r.Get(ctx, types.NamespacedName{Name: spec.TLSSecretName, Namespace: doclingServe.Namespace}, tlsSecret); err != nil {
+			log.Error(err, "Error reading Route TLS Secret", "Secret.Namespace", doclingServe.Namespace, "Secret.Name", spec.TLSSecretName)
+			return true, fmt.Errorf("failed to read route TLS secret %s: %w", spec.TLSSecretName, err)
+		}
+	}
+
+	// The router does not trust the issuers of cert-manager, the CA of the pod certificate is copied into the route.
+	var destinationCA string
+	if tlsProvider(doclingServe) == tlsProviderCertManager {
+		podTLSSecret := &corev1.Secret{}
+		if err := r.Get(ctx, types.NamespacedName{Name: tlsSecretName(doclingServe), Namespace: doclingServe.Namespace}, podTLSSecret); err != nil && !errors.IsNotFound(err) {
+			log.Error(err, "Error reading docling-serve TLS Secret", "Secret.Namespace", doclingServe.Namespace, "Secret.Name", tlsSecretName(doclingServe))
+			return true, err
+		}
+		destinationCA = string(podTLSSecret.Data[routeCACertificateKey])
+	}
+
+	annotations := map[string]string{}
+	for key, value := range spec.Annotations {
+		annotations[key] = value
+	}
+	// A timeout annotation of the user wins, the webhook rejects it together with the timeout field.
+	if _, ok := annotations[routeTimeoutAnnotation]; !ok {
+		timeout := spec.Timeout
+		if timeout == "" {
+			maxSyncWait, err := maxSyncWait(ctx, r.Client, doclingServe)
+			if err != nil {
+				log.Error(err, "Error reading docling-serve max sync wait")
+				return true, err
+			}
+			timeout = fmt.Sprintf("%ds", int64((maxSyncWait + routeTimeoutMargin).Seconds()))
+		}
+		annotations[routeTimeoutAnnotation] = timeout
+	}
+
 	route := &routev1.Route{ObjectMeta: metav1.ObjectMeta{Name: doclingServe.Name + "-route", Namespace: doclingServe.Namespace}}
 	_, err := controllerutil.CreateOrUpdate(ctx, r.Client, route, func() error {
 		labels := labelsForDocling(doclingServe.Name)
 		route.Labels = labels
+		mergeManagedAnnotations(route, annotations)
+
+		termination := routev1.TLSTerminationEdge
+		if spec.Termination != "" {
+			termination = routev1.TLSTerminationType(spec.Termination)
+		}
+		targetPort := servicePortName(doclingServe)
+		if oauthProxyEnabled(doclingServe) {
+			targetPort = oauthProxyPortName
+		}
+		// The pods only serve HTTPS, the router trusts the certificates issued by the service CA.
+		if (oauthProxyEnabled(doclingServe) || tlsEnabled(doclingServe)) && termination == routev1.TLSTerminationEdge {
+			termination = routev1.TLSTerminationReencrypt
+		}
+		path := spec.Path
+		if path == "" {
+			path = "/"
+		}
+		if termination == routev1.TLSTerminationPassthrough {
+			// The router cannot inspect the path of passthrough traffic.
+			path = ""
+		}
+		host := spec.Host
+		if host == "" && spec.Subdomain == "" {
+			// Keep the host generated by the router.
+			host = route.Spec.Host
+		}
+
 		route.Spec = routev1.RouteSpec{
-			Path: "/",
+			Host:      host,
+			Subdomain: spec.Subdomain,
+			Path:      path,
 			To: routev1.RouteTargetReference{
 				Kind: "Service",
 				Name: doclingServe.Name + "-service",
 			},
 			Port: &routev1.RoutePort{
-				TargetPort: intstr.FromString("http"),
+				TargetPort: intstr.FromString(targetPort),
 			},
 			TLS: &routev1.TLSConfig{
-				Termination: routev1.TLSTerminationEdge,
+				Termination:                   termination,
+				InsecureEdgeTerminationPolicy: routev1.InsecureEdgeTerminationPolicyType(spec.InsecureEdgeTerminationPolicy),
 			},
 		}
+		if tlsSecret != nil && termination != routev1.TLSTerminationPassthrough {
+			route.Spec.TLS.Certificate = string(tlsSecret.Data[corev1.TLSCertKey])
+			route.Spec.TLS.Key = string(tlsSecret.Data[corev1.TLSPrivateKeyKey])
+			route.Spec.TLS.CACertificate = string(tlsSecret.Data[routeCACertificateKey])
+			if termination == routev1.TLSTerminationReencrypt {
+				route.Spec.TLS.DestinationCACertificate = string(tlsSecret.Data[routeDestinationCAKey])
+			}
+		}
+		if termination == routev1.TLSTerminationReencrypt && route.Spec.TLS.DestinationCACertificate == "" && destinationCA != "" {
+			route.Spec.TLS.DestinationCACertificate = destinationCA
+		}
 		_ = ctrl.SetControllerReference(doclingServe, route, r.Scheme)
 		return nil
 	})
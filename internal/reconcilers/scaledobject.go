@@ -0,0 +1,161 @@
+package reconcilers
+
+import (
+	"context"
+
+	"github.io/docling-project/docling-operator/api/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// ScaledObjectGroupVersionKind identifies the KEDA ScaledObject. KEDA is an optional dependency, so ScaledObjects
+// are handled as unstructured objects instead of importing its API module.
+var ScaledObjectGroupVersionKind = schema.GroupVersionKind{Group: "keda.sh", Version: "v1alpha1", Kind: "ScaledObject"}
+
+type ScaledObjectReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+}
+
+func NewScaledObjectReconciler(client client.Client, scheme *runtime.Scheme) *ScaledObjectReconciler {
+	return &ScaledObjectReconciler{
+		Client: client,
+		Scheme: scheme,
+	}
+}
+
+// NewScaledObject returns an empty unstructured KEDA ScaledObject.
+func NewScaledObject() *unstructured.Unstructured {
+	scaledObject := &unstructured.Unstructured{}
+	scaledObject.SetGroupVersionKind(ScaledObjectGroupVersionKind)
+	return scaledObject
+}
+
+func (r *ScaledObjectReconciler) Reconcile(ctx context.Context, doclingServe *v1alpha1.DoclingServe) (bool, error) {
+	// The keda block is required by the CRD validation for the KEDA mode.
+	if autoscalingMode(doclingServe) == autoscalingModeKEDA && doclingServe.Spec.APIServer.Autoscaling.KEDA != nil {
+		return r.createOrUpdate(ctx, doclingServe)
+	}
+
+	return r.delete(ctx, doclingServe)
+}
+
+func (r *ScaledObjectReconciler) createOrUpdate(ctx context.Context, doclingServe *v1alpha1.DoclingServe) (bool, error) {
+	log := logf.FromContext(ctx)
+	autoscaling := doclingServe.Spec.APIServer.Autoscaling
+	scaledObject := NewScaledObject()
+	scaledObject.SetName(doclingServe.Name + "-scaledobject")
+	scaledObject.SetNamespace(doclingServe.Namespace)
+	_, err := controllerutil.CreateOrUpdate(ctx, r.Client, scaledObject, func() error {
+		scaledObject.SetLabels(labelsForDocling(doclingServe.Name))
+
+		spec := map[string]interface{}{
+			"scaleTargetRef": map[string]interface{}{
+				"apiVersion": "apps/v1",
+				"kind":       "Deployment",
+				"name":       doclingServe.Name + "-deployment",
+			},
+			"maxReplicaCount": int64(autoscaling.MaxReplicas),
+			"triggers":        []interface{}{scaledObjectTrigger(autoscaling.KEDA)},
+		}
+		if autoscaling.MinReplicas != nil {
+			spec["minReplicaCount"] = int64(*autoscaling.MinReplicas)
+		}
+		if autoscaling.KEDA.PollingInterval != nil {
+			spec["pollingInterval"] = int64(*autoscaling.KEDA.PollingInterval)
+		}
+		if autoscaling.KEDA.CooldownPeriod != nil {
+			spec["cooldownPeriod"] = int64(*autoscaling.KEDA.CooldownPeriod)
+		}
+		if autoscaling.Behavior != nil {
+			behavior, err := runtime.DefaultUnstructuredConverter.ToUnstructured(autoscaling.Behavior)
+			if err != nil {
+				return err
+			}
+			spec["advanced"] = map[string]interface{}{
+				"horizontalPodAutoscalerConfig": map[string]interface{}{
+					"behavior": behavior,
+				},
+			}
+		}
+		scaledObject.Object["spec"] = spec
+
+		_ = ctrl.SetControllerReference(doclingServe, scaledObject, r.Scheme)
+		return nil
+	})
+	if err != nil {
+		log.Error(err, "Error creating/updating ScaledObject", "ScaledObject.Namespace", scaledObject.GetNamespace(), "ScaledObject.Name", scaledObject.GetName())
+		return true, err
+	}
+
+	log.Info("Successfully created/updated ScaledObject", "ScaledObject.Namespace", scaledObject.GetNamespace(), "ScaledObject.Name", scaledObject.GetName())
+	return false, nil
+}
+
+func (r *ScaledObjectReconciler) delete(ctx context.Context, doclingServe *v1alpha1.DoclingServe) (bool, error) {
+	log := logf.FromContext(ctx)
+	scaledObject := NewScaledObject()
+	if err := r.Get(ctx, types.NamespacedName{Name: doclingServe.Name + "-scaledobject", Namespace: doclingServe.Namespace}, scaledObject); err != nil && !errors.IsNotFound(err) {
+		log.Error(err, "Error deleting ScaledObject", "ScaledObject.Namespace", doclingServe.Namespace, "ScaledObject.Name", doclingServe.Name+"-scaledobject")
+		return true, err
+	} else if errors.IsNotFound(err) {
+		return false, nil
+	}
+
+	if err := r.Delete(ctx, scaledObject); err != nil {
+		log.Error(err, "Error deleting ScaledObject", "ScaledObject.Namespace", scaledObject.GetNamespace(), "ScaledObject.Name", scaledObject.GetName())
+		return true, err
+	}
+
+	log.Info("Successfully deleted ScaledObject", "ScaledObject.Namespace", scaledObject.GetNamespace(), "ScaledObject.Name", scaledObject.GetName())
+	return false, nil
+}
+
+// scaledObjectTrigger returns the KEDA trigger for the configured queue depth source.
+func scaledObjectTrigger(keda *v1alpha1.KEDAAutoscaling) map[string]interface{} {
+	var trigger map[string]interface{}
+	var authenticationRef string
+	switch {
+	case keda.Prometheus != nil:
+		metadata := map[string]interface{}{
+			"serverAddress": keda.Prometheus.ServerAddress,
+			"query":         keda.Prometheus.Query,
+			"threshold":     keda.Prometheus.Threshold,
+		}
+		if keda.Prometheus.ActivationThreshold != "" {
+			metadata["activationThreshold"] = keda.Prometheus.ActivationThreshold
+		}
+		if keda.Prometheus.AuthenticationRef != "" {
+			authModes := keda.Prometheus.AuthModes
+			if authModes == "" {
+				authModes = "bearer"
+			}
+			metadata["authModes"] = authModes
+		}
+		trigger = map[string]interface{}{"type": "prometheus", "metadata": metadata}
+		authenticationRef = keda.Prometheus.AuthenticationRef
+	case keda.MetricsAPI != nil:
+		metadata := map[string]interface{}{
+			"url":           keda.MetricsAPI.URL,
+			"valueLocation": keda.MetricsAPI.ValueLocation,
+			"targetValue":   keda.MetricsAPI.TargetValue,
+			"format":        "json",
+		}
+		if keda.MetricsAPI.ActivationTargetValue != "" {
+			metadata["activationTargetValue"] = keda.MetricsAPI.ActivationTargetValue
+		}
+		trigger = map[string]interface{}{"type": "metrics-api", "metadata": metadata}
+		authenticationRef = keda.MetricsAPI.AuthenticationRef
+	}
+	if authenticationRef != "" {
+		trigger["authenticationRef"] = map[string]interface{}{"name": authenticationRef}
+	}
+	return trigger
+}
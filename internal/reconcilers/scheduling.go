@@ -0,0 +1,116 @@
+package reconcilers
+
+import (
+	"github.io/docling-project/docling-operator/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// applyScheduling sets the scheduling constraints of the docling-serve pods.
+func applyScheduling(doclingServe *v1alpha1.DoclingServe, podSpec *corev1.PodSpec) {
+	schedulePods(doclingServe.Spec.APIServer, labelsForDocling(doclingServe.Name), multipleReplicas(doclingServe) && !modelsOnSingleNode(doclingServe), podSpec)
+	colocateWithModels(doclingServe, podSpec)
+}
+
+// applyWorkerScheduling sets the scheduling constraints of the RQ worker pods. The constraints of spec.worker replace
+// the docling-serve pods ones, the default anti-affinity and the spread constraints apply among the workers.
+func applyWorkerScheduling(doclingServe *v1alpha1.DoclingServe, podSpec *corev1.PodSpec) {
+	scheduling := *doclingServe.Spec.APIServer
+	if worker := doclingServe.Spec.Worker; worker != nil {
+		if worker.NodeSelector != nil {
+			scheduling.NodeSelector = worker.NodeSelector
+		}
+		if worker.Tolerations != nil {
+			scheduling.Tolerations = worker.Tolerations
+		}
+		if worker.Affinity != nil {
+			scheduling.Affinity = worker.Affinity
+		}
+		if worker.TopologySpreadConstraints != nil {
+			scheduling.TopologySpreadConstraints = worker.TopologySpreadConstraints
+		}
+		if worker.PriorityClassName != "" {
+			scheduling.PriorityClassName = worker.PriorityClassName
+		}
+	}
+	schedulePods(&scheduling, labelsForWorker(doclingServe.Name), rqEngine(doclingServe).Workers > 1 && !modelsOnSingleNode(doclingServe), podSpec)
+	colocateWithModels(doclingServe, podSpec)
+}
+
+// schedulePods sets the scheduling constraints of a pod among the pods with the given labels.
+func schedulePods(apiServer *v1alpha1.APIServer, labels map[string]string, multipleReplicas bool, podSpec *corev1.PodSpec) {
+	podSpec.NodeSelector = apiServer.NodeSelector
+	podSpec.Tolerations = apiServer.Tolerations
+	podSpec.PriorityClassName = apiServer.PriorityClassName
+	podSpec.RuntimeClassName = apiServer.RuntimeClassName
+
+	podSpec.Affinity = apiServer.Affinity
+	if podSpec.Affinity == nil && multipleReplicas {
+		// Losing a node should not take every replica down, without preventing them from sharing a node.
+		podSpec.Affinity = &corev1.Affinity{
+			PodAntiAffinity: &corev1.PodAntiAffinity{
+				PreferredDuringSchedulingIgnoredDuringExecution: []corev1.WeightedPodAffinityTerm{{
+					Weight: 100,
+					PodAffinityTerm: corev1.PodAffinityTerm{
+						LabelSelector: &metav1.LabelSelector{MatchLabels: labels},
+						TopologyKey:   corev1.LabelHostname,
+					},
+				}},
+			},
+		}
+	}
+
+	for _, constraint := range apiServer.TopologySpreadConstraints {
+		if constraint.LabelSelector == nil {
+			constraint.LabelSelector = &metav1.LabelSelector{MatchLabels: labels}
+		}
+		podSpec.TopologySpreadConstraints = append(podSpec.TopologySpreadConstraints, constraint)
+	}
+}
+
+// modelsOnSingleNode reports whether the pods mount a ReadWriteOnce model volume, which can only be attached to one node.
+func modelsOnSingleNode(doclingServe *v1alpha1.DoclingServe) bool {
+	return modelsEnabled(doclingServe) && modelCacheName(doclingServe) == "" && modelsImage(doclingServe) == "" &&
+		doclingServe.Spec.Models.AccessMode != corev1.ReadWriteMany
+}
+
+// colocateWithModels requires the pods mounting a ReadWriteOnce model volume to run on the node of the other pods
+// mounting it: the docling-serve pods, the workers and the download job. The first of them runs on any node.
+func colocateWithModels(doclingServe *v1alpha1.DoclingServe, podSpec *corev1.PodSpec) {
+	if !modelsOnSingleNode(doclingServe) {
+		return
+	}
+
+	affinity := &corev1.Affinity{}
+	if podSpec.Affinity != nil {
+		affinity = podSpec.Affinity.DeepCopy()
+	}
+	if affinity.PodAffinity == nil {
+		affinity.PodAffinity = &corev1.PodAffinity{}
+	}
+	affinity.PodAffinity.RequiredDuringSchedulingIgnoredDuringExecution = append(affinity.PodAffinity.RequiredDuringSchedulingIgnoredDuringExecution,
+		corev1.PodAffinityTerm{
+			LabelSelector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{"doclingserve_cr": doclingServe.Name},
+				MatchExpressions: []metav1.LabelSelectorRequirement{{
+					Key:      "app",
+					Operator: metav1.LabelSelectorOpIn,
+					Values: []string{
+						labelsForDocling(doclingServe.Name)["app"],
+						labelsForWorker(doclingServe.Name)["app"],
+						labelsForModelsDownload(doclingServe.Name)["app"],
+					},
+				}},
+			},
+			TopologyKey: corev1.LabelHostname,
+		})
+	podSpec.Affinity = affinity
+}
+
+// multipleReplicas reports whether more than one docling-serve pod may run.
+func multipleReplicas(doclingServe *v1alpha1.DoclingServe) bool {
+	if autoscalingEnabled(doclingServe) {
+		return doclingServe.Spec.APIServer.Autoscaling.MaxReplicas > 1
+	}
+	return doclingServe.Spec.APIServer.Instances > 1
+}
@@ -0,0 +1,79 @@
+package reconcilers
+
+import (
+	"encoding/json"
+
+	"github.io/docling-project/docling-operator/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+)
+
+const (
+	// tmpMountPath is the temporary directory of the docling-serve container.
+	tmpMountPath = "/tmp"
+	// cacheMountPath receives the caches of docling and its libraries when the root filesystem is read-only. The
+	// home cache directory of the image holds the bundled models, so it cannot be shadowed by an empty volume.
+	cacheMountPath = "/var/cache/docling-serve"
+)
+
+// defaultPodSecurityContext complies with the restricted Pod Security Standard. The user and group are left to the
+// image, or to the security context constraints on OpenShift.
+func defaultPodSecurityContext() *corev1.PodSecurityContext {
+	return &corev1.PodSecurityContext{
+		RunAsNonRoot:   ptr.To(true),
+		SeccompProfile: &corev1.SeccompProfile{Type: corev1.SeccompProfileTypeRuntimeDefault},
+	}
+}
+
+// defaultSecurityContext complies with the restricted Pod Security Standard.
+func defaultSecurityContext() *corev1.SecurityContext {
+	return &corev1.SecurityContext{
+		AllowPrivilegeEscalation: ptr.To(false),
+		Capabilities:             &corev1.Capabilities{Drop: []corev1.Capability{"ALL"}},
+		RunAsNonRoot:             ptr.To(true),
+		SeccompProfile:           &corev1.SeccompProfile{Type: corev1.SeccompProfileTypeRuntimeDefault},
+	}
+}
+
+// podSecurityContext returns the restricted defaults with the fields set in the spec overriding them.
+func podSecurityContext(doclingServe *v1alpha1.DoclingServe) (*corev1.PodSecurityContext, error) {
+	return overlay(defaultPodSecurityContext(), doclingServe.Spec.APIServer.PodSecurityContext)
+}
+
+// containerSecurityContext returns the restricted defaults with the fields set in the spec overriding them.
+func containerSecurityContext(doclingServe *v1alpha1.DoclingServe) (*corev1.SecurityContext, error) {
+	return overlay(defaultSecurityContext(), doclingServe.Spec.APIServer.SecurityContext)
+}
+
+// overlay decodes the fields set in override over defaults. Nested structs are merged and lists are replaced.
+func overlay[T any](defaults *T, override *T) (*T, error) {
+	if override == nil {
+		return defaults, nil
+	}
+	data, err := json.Marshal(override)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, defaults); err != nil {
+		return nil, err
+	}
+	return defaults, nil
+}
+
+// readOnlyRootFilesystem reports whether the docling-serve container runs with a read-only root filesystem.
+func readOnlyRootFilesystem(securityContext *corev1.SecurityContext) bool {
+	return securityContext.ReadOnlyRootFilesystem != nil && *securityContext.ReadOnlyRootFilesystem
+}
+
+// writableVolumes returns the emptyDir volumes and mounts docling-serve writes to when its root filesystem is read-only.
+func writableVolumes() ([]corev1.Volume, []corev1.VolumeMount) {
+	volumes := []corev1.Volume{
+		{Name: "tmp", VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}}},
+		{Name: "cache", VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}}},
+	}
+	mounts := []corev1.VolumeMount{
+		{Name: "tmp", MountPath: tmpMountPath},
+		{Name: "cache", MountPath: cacheMountPath},
+	}
+	return volumes, mounts
+}
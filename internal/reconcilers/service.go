@@ -16,6 +16,9 @@ import (
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 )
 
+// doclingServePort is the port docling-serve listens on, and the port of the service unless the OAuth proxy is enabled.
+const doclingServePort = 5001
+
 type ServiceReconciler struct {
 	client.Client
 	Scheme *runtime.Scheme
@@ -35,13 +38,30 @@ func (r *ServiceReconciler) Reconcile(ctx context.Context, doclingServe *v1alpha
 		labels := labelsForDocling(doclingServe.Name)
 		service.Labels = labels
 		service.Spec.Selector = labels
-		service.Spec.Ports = []corev1.ServicePort{
-			{
-				Name:       "http",
-				Port:       5001,
-				TargetPort: intstr.FromInt32(5001),
-			},
+		// The proxy is the only way in while it is enabled, docling-serve itself is not exposed.
+		annotations := map[string]string{}
+		if oauthProxyEnabled(doclingServe) {
+			annotations[servingCertSecretAnnotation] = oauthProxyTLSSecretName(doclingServe)
+			service.Spec.Ports = []corev1.ServicePort{
+				{
+					Name:       oauthProxyPortName,
+					Port:       oauthProxyPort,
+					TargetPort: intstr.FromString(oauthProxyPortName),
+				},
+			}
+		} else {
+			if tlsProvider(doclingServe) == tlsProviderServiceCA {
+				annotations[servingCertSecretAnnotation] = tlsSecretName(doclingServe)
+			}
+			service.Spec.Ports = []corev1.ServicePort{
+				{
+					Name:       servicePortName(doclingServe),
+					Port:       doclingServePort,
+					TargetPort: intstr.FromInt32(doclingServePort),
+				},
+			}
 		}
+		mergeManagedAnnotations(service, annotations)
 		_ = ctrl.SetControllerReference(doclingServe, service, r.Scheme)
 		return nil
 	})
@@ -4,9 +4,12 @@ import (
 	"context"
 
 	"github.io/docling-project/docling-operator/api/v1alpha1"
+	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
@@ -18,7 +21,8 @@ type ServiceAccountReconciler struct {
 	Scheme *runtime.Scheme
 }
 
-const serviceAccountName = "docling-serve"
+// legacyServiceAccountName is the service account previously shared by all the DoclingServes of a namespace.
+const legacyServiceAccountName = "docling-serve"
 
 func NewServiceAccountReconciler(client client.Client, scheme *runtime.Scheme) *ServiceAccountReconciler {
 	return &ServiceAccountReconciler{
@@ -28,10 +32,30 @@ func NewServiceAccountReconciler(client client.Client, scheme *runtime.Scheme) *
 }
 
 func (r *ServiceAccountReconciler) Reconcile(ctx context.Context, doclingServe *v1alpha1.DoclingServe) (bool, error) {
+	var requeue bool
+	var err error
+	if doclingServe.Spec.APIServer.ServiceAccountName == "" {
+		requeue, err = r.createOrUpdate(ctx, doclingServe)
+	} else {
+		requeue, err = r.delete(ctx, doclingServe)
+	}
+	if err != nil {
+		return requeue, err
+	}
+
+	return r.releaseLegacyServiceAccount(ctx, doclingServe)
+}
+
+func (r *ServiceAccountReconciler) createOrUpdate(ctx context.Context, doclingServe *v1alpha1.DoclingServe) (bool, error) {
 	log := logf.FromContext(ctx)
-	serviceAccount := &corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Name: serviceAccountName, Namespace: doclingServe.Namespace}}
+	serviceAccount := &corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Name: doclingServe.Name + "-sa", Namespace: doclingServe.Namespace}}
 	_, err := controllerutil.CreateOrUpdate(ctx, r.Client, serviceAccount, func() error {
 		serviceAccount.Labels = labelsForDocling(doclingServe.Name)
+		annotations := map[string]string{}
+		if oauthProxyEnabled(doclingServe) {
+			annotations[oauthRedirectReferenceAnnotation] = oauthRedirectReference(doclingServe)
+		}
+		mergeManagedAnnotations(serviceAccount, annotations)
 		_ = ctrl.SetControllerReference(doclingServe, serviceAccount, r.Scheme)
 		return nil
 	})
@@ -43,3 +67,114 @@ func (r *ServiceAccountReconciler) Reconcile(ctx context.Context, doclingServe *
 	log.Info("Successfully created ServiceAccount", "ServiceAccount.Namespace", serviceAccount.Namespace, "ServiceAccount.Name", serviceAccount.Name)
 	return false, nil
 }
+
+func (r *ServiceAccountReconciler) delete(ctx context.Context, doclingServe *v1alpha1.DoclingServe) (bool, error) {
+	log := logf.FromContext(ctx)
+	serviceAccount := &corev1.ServiceAccount{}
+	err := r.Get(ctx, types.NamespacedName{Name: doclingServe.Name + "-sa", Namespace: doclingServe.Namespace}, serviceAccount)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return false, nil
+		}
+		log.Error(err, "Error getting ServiceAccount", "ServiceAccount.Namespace", doclingServe.Namespace, "ServiceAccount.Name", doclingServe.Name+"-sa")
+		return true, err
+	}
+
+	// A service account with the derived name may have been created by the user and referenced explicitly.
+	if doclingServe.Spec.APIServer.ServiceAccountName == serviceAccount.Name || !metav1.IsControlledBy(serviceAccount, doclingServe) {
+		return false, nil
+	}
+
+	if err := r.Delete(ctx, serviceAccount); err != nil && !errors.IsNotFound(err) {
+		log.Error(err, "Error deleting ServiceAccount", "ServiceAccount.Namespace", serviceAccount.Namespace, "ServiceAccount.Name", serviceAccount.Name)
+		return true, err
+	}
+
+	log.Info("Successfully deleted ServiceAccount", "ServiceAccount.Namespace", serviceAccount.Namespace, "ServiceAccount.Name", serviceAccount.Name)
+	return false, nil
+}
+
+// releaseLegacyServiceAccount migrates away from the shared docling-serve service account created by earlier versions.
+// Only the first DoclingServe reconciled by them owns the account, although its label names the last one. The owner
+// stops owning it right away, so that deleting it does not garbage collect the identity of the other DoclingServes,
+// and any DoclingServe deletes it once no docling-serve deployment of the namespace runs with it anymore.
+func (r *ServiceAccountReconciler) releaseLegacyServiceAccount(ctx context.Context, doclingServe *v1alpha1.DoclingServe) (bool, error) {
+	log := logf.FromContext(ctx)
+	if doclingServe.Spec.APIServer.ServiceAccountName == legacyServiceAccountName {
+		return false, nil
+	}
+
+	serviceAccount := &corev1.ServiceAccount{}
+	err := r.Get(ctx, types.NamespacedName{Name: legacyServiceAccountName, Namespace: doclingServe.Namespace}, serviceAccount)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return false, nil
+		}
+		log.Error(err, "Error getting ServiceAccount", "ServiceAccount.Namespace", doclingServe.Namespace, "ServiceAccount.Name", legacyServiceAccountName)
+		return true, err
+	}
+	// An account of the same name created by the user is left alone.
+	if _, ok := serviceAccount.Labels["doclingserve_cr"]; !ok {
+		return false, nil
+	}
+
+	inUse, err := r.legacyServiceAccountInUse(ctx, doclingServe)
+	if err != nil {
+		log.Error(err, "Error listing Deployments", "Deployment.Namespace", doclingServe.Namespace)
+		return true, err
+	}
+
+	if !inUse {
+		if err := r.Delete(ctx, serviceAccount); err != nil && !errors.IsNotFound(err) {
+			log.Error(err, "Error deleting ServiceAccount", "ServiceAccount.Namespace", serviceAccount.Namespace, "ServiceAccount.Name", serviceAccount.Name)
+			return true, err
+		}
+		log.Info("Successfully deleted ServiceAccount", "ServiceAccount.Namespace", serviceAccount.Namespace, "ServiceAccount.Name", serviceAccount.Name)
+		return false, nil
+	}
+
+	ownerReferences := []metav1.OwnerReference{}
+	for _, ownerReference := range serviceAccount.OwnerReferences {
+		if ownerReference.UID != doclingServe.UID {
+			ownerReferences = append(ownerReferences, ownerReference)
+		}
+	}
+	if len(ownerReferences) != len(serviceAccount.OwnerReferences) {
+		patch := client.MergeFrom(serviceAccount.DeepCopy())
+		serviceAccount.OwnerReferences = ownerReferences
+		if err := r.Patch(ctx, serviceAccount, patch); err != nil {
+			log.Error(err, "Error updating ServiceAccount", "ServiceAccount.Namespace", serviceAccount.Namespace, "ServiceAccount.Name", serviceAccount.Name)
+			return true, err
+		}
+		log.Info("Successfully updated ServiceAccount", "ServiceAccount.Namespace", serviceAccount.Namespace, "ServiceAccount.Name", serviceAccount.Name)
+	}
+
+	return false, nil
+}
+
+// legacyServiceAccountInUse reports whether a docling-serve deployment of the namespace still runs pods with the
+// shared service account, including the deployment of this DoclingServe while it rolls out to its own account.
+func (r *ServiceAccountReconciler) legacyServiceAccountInUse(ctx context.Context, doclingServe *v1alpha1.DoclingServe) (bool, error) {
+	deployments := &appsv1.DeploymentList{}
+	if err := r.List(ctx, deployments, client.InNamespace(doclingServe.Namespace), client.MatchingLabels{"app": "docling-serve"}); err != nil {
+		return false, err
+	}
+	for i := range deployments.Items {
+		deployment := &deployments.Items[i]
+		if deployment.Spec.Template.Spec.ServiceAccountName == legacyServiceAccountName {
+			return true, nil
+		}
+		if deployment.Name == doclingServe.Name+"-deployment" && !deploymentRolledOut(deployment) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// serviceAccountName returns the service account the docling-serve pods run with.
+func serviceAccountName(doclingServe *v1alpha1.DoclingServe) string {
+	if doclingServe.Spec.APIServer.ServiceAccountName != "" {
+		return doclingServe.Spec.APIServer.ServiceAccountName
+	}
+	return doclingServe.Name + "-sa"
+}
@@ -0,0 +1,180 @@
+package reconcilers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.io/docling-project/docling-operator/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// maxSyncWaitEnv is the docling-serve setting limiting how long synchronous conversions wait for a result.
+	maxSyncWaitEnv = "DOCLING_SERVE_MAX_SYNC_WAIT"
+	// defaultMaxSyncWait is docling-serve's default for DOCLING_SERVE_MAX_SYNC_WAIT.
+	defaultMaxSyncWait = 120 * time.Second
+)
+
+// maxSyncWait returns the max sync wait docling-serve is configured with, falling back to the docling-serve default.
+// DOCLING_SERVE_MAX_SYNC_WAIT is resolved like the kubelet does: apiServer.env follows the typed settings in the
+// container and overrides them, both override the envFrom sources, of which the last one defining it wins.
+func maxSyncWait(ctx context.Context, c client.Client, doclingServe *v1alpha1.DoclingServe) (time.Duration, error) {
+	apiServer := doclingServe.Spec.APIServer
+	if apiServer == nil {
+		return defaultMaxSyncWait, nil
+	}
+	for i := len(apiServer.Env) - 1; i >= 0; i-- {
+		env := apiServer.Env[i]
+		if env.Name != maxSyncWaitEnv {
+			continue
+		}
+		value, found, source := env.Value, true, "apiServer.env"
+		if from := env.ValueFrom; from != nil {
+			var err error
+			switch {
+			case from.ConfigMapKeyRef != nil:
+				source = "ConfigMap " + from.ConfigMapKeyRef.Name
+				value, found, err = configMapValue(ctx, c, doclingServe.Namespace, from.ConfigMapKeyRef.Name, from.ConfigMapKeyRef.Key)
+			case from.SecretKeyRef != nil:
+				source = "Secret " + from.SecretKeyRef.Name
+				value, found, err = secretValue(ctx, c, doclingServe.Namespace, from.SecretKeyRef.Name, from.SecretKeyRef.Key)
+			default:
+				found = false
+			}
+			if err != nil {
+				return 0, err
+			}
+		}
+		if found {
+			return parseMaxSyncWait(value, source)
+		}
+	}
+	if settings := apiServer.Settings; settings != nil && settings.MaxSyncWait != nil {
+		return time.Duration(*settings.MaxSyncWait) * time.Second, nil
+	}
+
+	var sources []corev1.EnvFromSource
+	if apiServer.ConfigMapName != "" {
+		sources = append(sources, corev1.EnvFromSource{
+			ConfigMapRef: &corev1.ConfigMapEnvSource{LocalObjectReference: corev1.LocalObjectReference{Name: apiServer.ConfigMapName}},
+		})
+	}
+	sources = append(sources, apiServer.EnvFrom...)
+	for i := len(sources) - 1; i >= 0; i-- {
+		from := sources[i]
+		if !strings.HasPrefix(maxSyncWaitEnv, from.Prefix) {
+			continue
+		}
+		key := strings.TrimPrefix(maxSyncWaitEnv, from.Prefix)
+		var value, source string
+		var found bool
+		var err error
+		switch {
+		case from.ConfigMapRef != nil:
+			source = "ConfigMap " + from.ConfigMapRef.Name
+			value, found, err = configMapValue(ctx, c, doclingServe.Namespace, from.ConfigMapRef.Name, key)
+		case from.SecretRef != nil:
+			source = "Secret " + from.SecretRef.Name
+			value, found, err = secretValue(ctx, c, doclingServe.Namespace, from.SecretRef.Name, key)
+		}
+		if err != nil {
+			return 0, err
+		}
+		if found {
+			return parseMaxSyncWait(value, source)
+		}
+	}
+	return defaultMaxSyncWait, nil
+}
+
+// parseMaxSyncWait parses a DOCLING_SERVE_MAX_SYNC_WAIT value in seconds, read from source.
+func parseMaxSyncWait(value, source string) (time.Duration, error) {
+	seconds, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s value %q in %s: %w", maxSyncWaitEnv, value, source, err)
+	}
+	return time.Duration(seconds) * time.Second, nil
+}
+
+// configMapValue returns the value of a key of a ConfigMap, and whether both exist.
+func configMapValue(ctx context.Context, c client.Client, namespace, name, key string) (string, bool, error) {
+	configMap := &corev1.ConfigMap{}
+	if err := c.Get(ctx, types.NamespacedName{Name: name, Namespace: namespace}, configMap); err != nil {
+		if errors.IsNotFound(err) {
+			return "", false, nil
+		}
+		return "", false, err
+	}
+	value, ok := configMap.Data[key]
+	return value, ok, nil
+}
+
+// secretValue returns the value of a key of a Secret, and whether both exist.
+func secretValue(ctx context.Context, c client.Client, namespace, name, key string) (string, bool, error) {
+	secret := &corev1.Secret{}
+	if err := c.Get(ctx, types.NamespacedName{Name: name, Namespace: namespace}, secret); err != nil {
+		if errors.IsNotFound(err) {
+			return "", false, nil
+		}
+		return "", false, err
+	}
+	value, ok := secret.Data[key]
+	return string(value), ok, nil
+}
+
+// settingsEnv returns the environment variables docling-serve reads the typed settings from.
+func settingsEnv(doclingServe *v1alpha1.DoclingServe) ([]corev1.EnvVar, error) {
+	settings := doclingServe.Spec.APIServer.Settings
+	if settings == nil {
+		return nil, nil
+	}
+
+	var env []corev1.EnvVar
+	add := func(name, value string) {
+		env = append(env, corev1.EnvVar{Name: name, Value: value})
+	}
+	if settings.MaxSyncWait != nil {
+		add(maxSyncWaitEnv, strconv.Itoa(int(*settings.MaxSyncWait)))
+	}
+	if settings.MaxNumPages != nil {
+		add("DOCLING_SERVE_MAX_NUM_PAGES", strconv.Itoa(int(*settings.MaxNumPages)))
+	}
+	if settings.MaxFileSize != nil {
+		add("DOCLING_SERVE_MAX_FILE_SIZE", strconv.FormatInt(settings.MaxFileSize.Value(), 10))
+	}
+	if settings.ResultRemovalDelay != nil {
+		add("DOCLING_SERVE_RESULT_REMOVAL_DELAY", strconv.FormatFloat(settings.ResultRemovalDelay.Seconds(), 'f', -1, 64))
+	}
+	if settings.EnableRemoteServices != nil {
+		add("DOCLING_SERVE_ENABLE_REMOTE_SERVICES", strconv.FormatBool(*settings.EnableRemoteServices))
+	}
+	// docling-serve parses the list settings as JSON.
+	if len(settings.AllowedOCREngines) > 0 {
+		engines, err := json.Marshal(settings.AllowedOCREngines)
+		if err != nil {
+			return nil, err
+		}
+		add("DOCLING_SERVE_ALLOWED_OCR_ENGINES", string(engines))
+	}
+	if len(settings.CORSOrigins) > 0 {
+		origins, err := json.Marshal(settings.CORSOrigins)
+		if err != nil {
+			return nil, err
+		}
+		add("DOCLING_SERVE_CORS_ORIGINS", string(origins))
+	}
+	if settings.LogLevel != "" {
+		add("UVICORN_LOG_LEVEL", strings.ToLower(settings.LogLevel))
+	}
+	if settings.ArtifactsPath != "" {
+		add("DOCLING_SERVE_ARTIFACTS_PATH", settings.ArtifactsPath)
+	}
+	return env, nil
+}
@@ -3,29 +3,38 @@ package reconcilers
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	routev1 "github.com/openshift/api/route/v1"
 	"github.io/docling-project/docling-operator/api/v1alpha1"
 	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
 )
 
 type StatusReconciler struct {
 	client.Client
-	Scheme *runtime.Scheme
+	Scheme       *runtime.Scheme
+	Capabilities Capabilities
 }
 
-func NewStatusReconciler(client client.Client, scheme *runtime.Scheme) *StatusReconciler {
+func NewStatusReconciler(client client.Client, scheme *runtime.Scheme, capabilities Capabilities) *StatusReconciler {
 	return &StatusReconciler{
-		Client: client,
-		Scheme: scheme,
+		Client:       client,
+		Scheme:       scheme,
+		Capabilities: capabilities,
 	}
 }
 
@@ -33,6 +42,8 @@ func (r *StatusReconciler) Reconcile(ctx context.Context, doclingServe *v1alpha1
 	log := logf.FromContext(ctx, "Status.ObservedGeneration", doclingServe.Generation)
 	ctx = logf.IntoContext(ctx, log)
 	doclingServe.Status.ObservedGeneration = doclingServe.Generation
+	doclingServe.Status.URL = ""
+	doclingServe.Status.APIKeySecretName = apiKeySecretName(doclingServe)
 
 	var err error
 	var requeue bool
@@ -45,15 +56,48 @@ func (r *StatusReconciler) Reconcile(ctx context.Context, doclingServe *v1alpha1
 		}
 	}()
 
+	// Update models status, the deployment waits for them
+	r.reconcileDoclingModelsStatus(ctx, doclingServe)
+
+	// Update the status of the Redis server deployed for the RQ engine
+	r.reconcileDoclingRedisStatus(ctx, doclingServe)
+
 	// Update deployment status
-	r.reconcileDoclingDeploymentStatus(ctx, doclingServe)
+	deployment := r.reconcileDoclingDeploymentStatus(ctx, doclingServe)
+
+	// Update the status of the RQ workers deployment
+	worker := r.reconcileDoclingWorkerDeploymentStatus(ctx, doclingServe)
+
+	// Update the status of the referenced config maps and secrets
+	r.reconcileDoclingConfigReferencesStatus(ctx, doclingServe)
+
+	// Update autoscaler status
+	r.reconcileDoclingAutoscalerStatus(ctx, doclingServe)
+
+	// Update KEDA ScaledObject status
+	r.reconcileDoclingScaledObjectStatus(ctx, doclingServe)
+
+	// Update cert-manager Certificate status
+	r.reconcileDoclingCertificateStatus(ctx, doclingServe)
 
 	// Update service status
 	r.reconcileDoclingServiceStatus(ctx, doclingServe)
 
+	// Update network policy status
+	r.reconcileDoclingNetworkPolicyStatus(ctx, doclingServe)
+
 	// Update route status
 	r.reconcileDoclingRouteStatus(ctx, doclingServe)
 
+	// Update ingress status
+	r.reconcileDoclingIngressStatus(ctx, doclingServe)
+
+	// Update HTTPRoute status
+	r.reconcileDoclingHTTPRouteStatus(ctx, doclingServe)
+
+	// Summarize the above into the Ready, Progressing and Degraded conditions
+	r.reconcileDoclingAggregatedStatus(doclingServe, deployment, worker)
+
 	return requeue, err
 }
 
@@ -72,21 +116,175 @@ func (r *StatusReconciler) commitStatus(ctx context.Context, doclingServe *v1alp
 	return err
 }
 
-func (r *StatusReconciler) reconcileDoclingDeploymentStatus(ctx context.Context, doclingServe *v1alpha1.DoclingServe) {
+// reconcileDoclingModelsStatus reports whether the models mounted by the docling-serve pods are downloaded.
+func (r *StatusReconciler) reconcileDoclingModelsStatus(ctx context.Context, doclingServe *v1alpha1.DoclingServe) {
+	log := logf.FromContext(ctx)
+	if !modelsEnabled(doclingServe) {
+		// No models are preloaded, so clear the condition and return
+		meta.RemoveStatusCondition(&doclingServe.Status.Conditions, "ModelsReady")
+		return
+	}
+	if image := modelsImage(doclingServe); image != "" {
+		condition := metav1.Condition{
+			Type:               "ModelsReady",
+			Status:             metav1.ConditionTrue,
+			ObservedGeneration: doclingServe.Generation,
+			Reason:             "ModelsReady",
+			Message:            fmt.Sprintf("The docling models are mounted from the image %s", image),
+		}
+		meta.SetStatusCondition(&doclingServe.Status.Conditions, condition)
+		return
+	}
+	if modelCacheName(doclingServe) != "" {
+		r.reconcileDoclingModelCacheStatus(ctx, doclingServe)
+		return
+	}
+
+	downloaded, job, err := modelsDownloaded(ctx, r.Client, doclingServe)
+	if err != nil {
+		log.Error(err, "failed to get doclingServe models download job")
+		condition := metav1.Condition{
+			Type:               "ModelsReady",
+			Status:             metav1.ConditionUnknown,
+			ObservedGeneration: doclingServe.Generation,
+			Reason:             "ModelsStatusError",
+			Message:            err.Error(),
+		}
+		meta.SetStatusCondition(&doclingServe.Status.Conditions, condition)
+		return
+	}
+
+	condition := metav1.Condition{
+		Type:               "ModelsReady",
+		Status:             metav1.ConditionFalse,
+		ObservedGeneration: doclingServe.Generation,
+		Reason:             "ModelsDownloading",
+		Message:            "The docling models are being downloaded",
+	}
+	switch {
+	case downloaded:
+		condition.Status = metav1.ConditionTrue
+		condition.Reason = "ModelsReady"
+		condition.Message = "The docling models were downloaded"
+	case job != nil && jobConditionTrue(job, batchv1.JobFailed):
+		condition.Reason = "ModelsDownloadFailed"
+		condition.Message = fmt.Sprintf("The docling models download job %s failed, check its logs", job.Name)
+	}
+	meta.SetStatusCondition(&doclingServe.Status.Conditions, condition)
+}
+
+// reconcileDoclingModelCacheStatus reports the readiness of the DoclingModelCache mounted by the docling-serve pods.
+func (r *StatusReconciler) reconcileDoclingModelCacheStatus(ctx context.Context, doclingServe *v1alpha1.DoclingServe) {
+	log := logf.FromContext(ctx)
+	cacheName := modelCacheName(doclingServe)
+	cache, err := modelCache(ctx, r.Client, doclingServe)
+	if err != nil {
+		log.Error(err, "failed to get doclingServe model cache")
+		condition := metav1.Condition{
+			Type:               "ModelsReady",
+			Status:             metav1.ConditionUnknown,
+			ObservedGeneration: doclingServe.Generation,
+			Reason:             "ModelsStatusError",
+			Message:            err.Error(),
+		}
+		meta.SetStatusCondition(&doclingServe.Status.Conditions, condition)
+		return
+	}
+
+	condition := metav1.Condition{
+		Type:               "ModelsReady",
+		Status:             metav1.ConditionFalse,
+		ObservedGeneration: doclingServe.Generation,
+		Reason:             "ModelsDownloading",
+		Message:            fmt.Sprintf("Waiting for the DoclingModelCache %s to download the docling models", cacheName),
+	}
+	switch {
+	case cache == nil:
+		condition.Reason = "ModelCacheNotFound"
+		condition.Message = fmt.Sprintf("The DoclingModelCache %s does not exist", cacheName)
+	case modelCacheReady(cache):
+		condition.Status = metav1.ConditionTrue
+		condition.Reason = "ModelsReady"
+		condition.Message = fmt.Sprintf("The docling models of the DoclingModelCache %s are ready", cacheName)
+	default:
+		if ready := meta.FindStatusCondition(cache.Status.Conditions, "Ready"); ready != nil && ready.Reason == "DownloadFailed" {
+			condition.Reason = "ModelsDownloadFailed"
+			condition.Message = fmt.Sprintf("DoclingModelCache %s: %s", cacheName, ready.Message)
+		}
+	}
+	meta.SetStatusCondition(&doclingServe.Status.Conditions, condition)
+}
+
+// reconcileDoclingRedisStatus reports whether the Redis server deployed by the operator for the RQ engine is ready.
+func (r *StatusReconciler) reconcileDoclingRedisStatus(ctx context.Context, doclingServe *v1alpha1.DoclingServe) {
+	log := logf.FromContext(ctx)
+	if managedRedis(doclingServe) == nil {
+		// No Redis server is deployed by the operator, so clear its condition and return
+		meta.RemoveStatusCondition(&doclingServe.Status.Conditions, "RedisReady")
+		return
+	}
+
+	statefulSet := appsv1.StatefulSet{}
+	if err := r.Get(ctx, types.NamespacedName{Name: redisName(doclingServe), Namespace: doclingServe.Namespace}, &statefulSet); err != nil {
+		log.Error(err, "failed to get doclingServe Redis stateful set")
+		condition := metav1.Condition{
+			Type:               "RedisReady",
+			Status:             metav1.ConditionUnknown,
+			ObservedGeneration: doclingServe.Generation,
+			Reason:             "RedisStatusError",
+			Message:            err.Error(),
+		}
+		meta.SetStatusCondition(&doclingServe.Status.Conditions, condition)
+		return
+	}
+
+	condition := metav1.Condition{
+		Type:               "RedisReady",
+		Status:             metav1.ConditionFalse,
+		ObservedGeneration: doclingServe.Generation,
+		Reason:             "RedisNotReady",
+		Message:            "The Redis server of the RQ engine is starting",
+	}
+	if statefulSet.Status.ReadyReplicas > 0 {
+		condition.Status = metav1.ConditionTrue
+		condition.Reason = "RedisReady"
+		condition.Message = "The Redis server of the RQ engine is ready"
+	}
+	meta.SetStatusCondition(&doclingServe.Status.Conditions, condition)
+}
+
+func (r *StatusReconciler) reconcileDoclingDeploymentStatus(ctx context.Context, doclingServe *v1alpha1.DoclingServe) *appsv1.Deployment {
 	log := logf.FromContext(ctx)
 	deployment := appsv1.Deployment{}
 	if err := r.Get(ctx, types.NamespacedName{Name: fmt.Sprintf("%s-deployment", doclingServe.Name), Namespace: doclingServe.Namespace}, &deployment); err != nil {
+		if apierrors.IsNotFound(err) && meta.IsStatusConditionFalse(doclingServe.Status.Conditions, "ModelsReady") {
+			condition := metav1.Condition{
+				Type:               "DeploymentCreated",
+				Status:             metav1.ConditionFalse,
+				ObservedGeneration: doclingServe.Generation,
+				Reason:             "WaitingForModels",
+				Message:            "The docling deployment is created once the models are downloaded",
+			}
+			meta.SetStatusCondition(&doclingServe.Status.Conditions, condition)
+			return nil
+		}
 		log.Error(err, "failed to get doclingServe deployment")
 		condition := metav1.Condition{
 			Type:               "DeploymentCreated",
 			Status:             metav1.ConditionUnknown,
-			ObservedGeneration: deployment.Generation,
-			LastTransitionTime: metav1.Time{},
+			ObservedGeneration: doclingServe.Generation,
 			Reason:             "DeploymentStatusError",
 			Message:            err.Error(),
 		}
 		meta.SetStatusCondition(&doclingServe.Status.Conditions, condition)
-		return
+		return nil
+	}
+
+	// Set scale subresource status
+	doclingServe.Status.Replicas = deployment.Status.Replicas
+	doclingServe.Status.ReadyReplicas = deployment.Status.ReadyReplicas
+	if selector, err := metav1.LabelSelectorAsSelector(deployment.Spec.Selector); err == nil {
+		doclingServe.Status.Selector = selector.String()
 	}
 
 	// Set created status
@@ -94,8 +292,7 @@ func (r *StatusReconciler) reconcileDoclingDeploymentStatus(ctx context.Context,
 		condition := metav1.Condition{
 			Type:               "DeploymentCreated",
 			Status:             metav1.ConditionTrue,
-			ObservedGeneration: deployment.Generation,
-			LastTransitionTime: metav1.Time{},
+			ObservedGeneration: doclingServe.Generation,
 			Reason:             "DeploymentCreated",
 			Message:            "The docling deployment was created successfully",
 		}
@@ -108,8 +305,8 @@ func (r *StatusReconciler) reconcileDoclingDeploymentStatus(ctx context.Context,
 			condition := metav1.Condition{
 				Type:               "DeploymentAvailable",
 				Status:             metav1.ConditionStatus(deployCondition.Status),
-				ObservedGeneration: deployment.Generation,
-				LastTransitionTime: metav1.Time{},
+				ObservedGeneration: doclingServe.Generation,
+				LastTransitionTime: deployCondition.LastTransitionTime,
 				Reason:             deployCondition.Reason,
 				Message:            deployCondition.Message,
 			}
@@ -117,19 +314,330 @@ func (r *StatusReconciler) reconcileDoclingDeploymentStatus(ctx context.Context,
 		}
 	}
 
-	// Set misc statuses for troubleshooting
-	if len(deployment.Status.Conditions) > 0 {
-		deployCondition := deployment.Status.Conditions[len(deployment.Status.Conditions)-1]
+	// ReplicaFailure used to be copied from the deployment, it is now reported through Degraded
+	meta.RemoveStatusCondition(&doclingServe.Status.Conditions, string(appsv1.DeploymentReplicaFailure))
+
+	return &deployment
+}
+
+// reconcileDoclingWorkerDeploymentStatus reports the state of the RQ workers apart from the docling-serve pods.
+func (r *StatusReconciler) reconcileDoclingWorkerDeploymentStatus(ctx context.Context, doclingServe *v1alpha1.DoclingServe) *appsv1.Deployment {
+	log := logf.FromContext(ctx)
+	doclingServe.Status.WorkerReplicas = 0
+	doclingServe.Status.WorkerReadyReplicas = 0
+	if rqEngine(doclingServe) == nil {
+		// Only the RQ engine runs separate workers, so clear their conditions and return
+		meta.RemoveStatusCondition(&doclingServe.Status.Conditions, "WorkerDeploymentCreated")
+		meta.RemoveStatusCondition(&doclingServe.Status.Conditions, "WorkerDeploymentAvailable")
+		return nil
+	}
+
+	deployment := appsv1.Deployment{}
+	if err := r.Get(ctx, types.NamespacedName{Name: workerDeploymentName(doclingServe), Namespace: doclingServe.Namespace}, &deployment); err != nil {
+		if apierrors.IsNotFound(err) && meta.IsStatusConditionFalse(doclingServe.Status.Conditions, "ModelsReady") {
+			condition := metav1.Condition{
+				Type:               "WorkerDeploymentCreated",
+				Status:             metav1.ConditionFalse,
+				ObservedGeneration: doclingServe.Generation,
+				Reason:             "WaitingForModels",
+				Message:            "The docling worker deployment is created once the models are downloaded",
+			}
+			meta.SetStatusCondition(&doclingServe.Status.Conditions, condition)
+			return nil
+		}
+		log.Error(err, "failed to get doclingServe worker deployment")
+		condition := metav1.Condition{
+			Type:               "WorkerDeploymentCreated",
+			Status:             metav1.ConditionUnknown,
+			ObservedGeneration: doclingServe.Generation,
+			Reason:             "WorkerDeploymentStatusError",
+			Message:            err.Error(),
+		}
+		meta.SetStatusCondition(&doclingServe.Status.Conditions, condition)
+		return nil
+	}
+
+	doclingServe.Status.WorkerReplicas = deployment.Status.Replicas
+	doclingServe.Status.WorkerReadyReplicas = deployment.Status.ReadyReplicas
+
+	condition := metav1.Condition{
+		Type:               "WorkerDeploymentCreated",
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: doclingServe.Generation,
+		Reason:             "WorkerDeploymentCreated",
+		Message:            "The docling worker deployment was created successfully",
+	}
+	meta.SetStatusCondition(&doclingServe.Status.Conditions, condition)
+
+	for _, deployCondition := range deployment.Status.Conditions {
+		if deployCondition.Type == appsv1.DeploymentAvailable {
+			condition := metav1.Condition{
+				Type:               "WorkerDeploymentAvailable",
+				Status:             metav1.ConditionStatus(deployCondition.Status),
+				ObservedGeneration: doclingServe.Generation,
+				LastTransitionTime: deployCondition.LastTransitionTime,
+				Reason:             deployCondition.Reason,
+				Message:            deployCondition.Message,
+			}
+			meta.SetStatusCondition(&doclingServe.Status.Conditions, condition)
+		}
+	}
+
+	return &deployment
+}
+
+func (r *StatusReconciler) reconcileDoclingConfigReferencesStatus(ctx context.Context, doclingServe *v1alpha1.DoclingServe) {
+	log := logf.FromContext(ctx)
+	if len(configReferences(doclingServe)) == 0 {
+		// No config map or secret is referenced, so clear the condition and return
+		meta.RemoveStatusCondition(&doclingServe.Status.Conditions, "ConfigReferencesResolved")
+		return
+	}
+
+	_, missing, err := configHash(ctx, r.Client, doclingServe)
+	if err != nil {
+		log.Error(err, "failed to get doclingServe config references")
+		condition := metav1.Condition{
+			Type:               "ConfigReferencesResolved",
+			Status:             metav1.ConditionUnknown,
+			ObservedGeneration: doclingServe.Generation,
+			Reason:             "ConfigReferencesStatusError",
+			Message:            err.Error(),
+		}
+		meta.SetStatusCondition(&doclingServe.Status.Conditions, condition)
+		return
+	}
+
+	if len(missing) > 0 {
+		condition := metav1.Condition{
+			Type:               "ConfigReferencesResolved",
+			Status:             metav1.ConditionFalse,
+			ObservedGeneration: doclingServe.Generation,
+			Reason:             "ReferencedObjectNotFound",
+			Message:            fmt.Sprintf("The docling-serve pods cannot start until these objects are created: %s", strings.Join(missing, ", ")),
+		}
+		meta.SetStatusCondition(&doclingServe.Status.Conditions, condition)
+		return
+	}
+
+	condition := metav1.Condition{
+		Type:               "ConfigReferencesResolved",
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: doclingServe.Generation,
+		Reason:             "ConfigReferencesResolved",
+		Message:            "The docling config maps and secrets were found",
+	}
+	meta.SetStatusCondition(&doclingServe.Status.Conditions, condition)
+}
+
+func (r *StatusReconciler) reconcileDoclingAutoscalerStatus(ctx context.Context, doclingServe *v1alpha1.DoclingServe) {
+	log := logf.FromContext(ctx)
+	if autoscalingMode(doclingServe) != autoscalingModeResource {
+		// The HorizontalPodAutoscaler is not managed by the operator, so clear its conditions and return
+		meta.RemoveStatusCondition(&doclingServe.Status.Conditions, "HorizontalPodAutoscalerCreated")
+		meta.RemoveStatusCondition(&doclingServe.Status.Conditions, "ScalingActive")
+		meta.RemoveStatusCondition(&doclingServe.Status.Conditions, "ScalingLimited")
+		return
+	}
+
+	hpa := autoscalingv2.HorizontalPodAutoscaler{}
+	if err := r.Get(ctx, types.NamespacedName{Name: fmt.Sprintf("%s-hpa", doclingServe.Name), Namespace: doclingServe.Namespace}, &hpa); err != nil {
+		log.Error(err, "failed to get doclingServe horizontalpodautoscaler")
+		condition := metav1.Condition{
+			Type:               "HorizontalPodAutoscalerCreated",
+			Status:             metav1.ConditionUnknown,
+			ObservedGeneration: doclingServe.Generation,
+			Reason:             "HorizontalPodAutoscalerStatusError",
+			Message:            err.Error(),
+		}
+		meta.SetStatusCondition(&doclingServe.Status.Conditions, condition)
+		return
+	}
+
+	// Set created status
+	condition := metav1.Condition{
+		Type:               "HorizontalPodAutoscalerCreated",
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: doclingServe.Generation,
+		Reason:             "HorizontalPodAutoscalerCreated",
+		Message:            "The docling horizontal pod autoscaler was created successfully",
+	}
+	meta.SetStatusCondition(&doclingServe.Status.Conditions, condition)
+
+	// Set the autoscaler's view on whether it can compute and apply the replica count
+	for _, hpaCondition := range hpa.Status.Conditions {
+		if hpaCondition.Type != autoscalingv2.ScalingActive && hpaCondition.Type != autoscalingv2.ScalingLimited {
+			continue
+		}
+		condition := metav1.Condition{
+			Type:               string(hpaCondition.Type),
+			Status:             metav1.ConditionStatus(hpaCondition.Status),
+			ObservedGeneration: doclingServe.Generation,
+			LastTransitionTime: hpaCondition.LastTransitionTime,
+			Reason:             hpaCondition.Reason,
+			Message:            hpaCondition.Message,
+		}
+		meta.SetStatusCondition(&doclingServe.Status.Conditions, condition)
+	}
+}
+
+func (r *StatusReconciler) reconcileDoclingScaledObjectStatus(ctx context.Context, doclingServe *v1alpha1.DoclingServe) {
+	log := logf.FromContext(ctx)
+	if autoscalingMode(doclingServe) != autoscalingModeKEDA {
+		// KEDA autoscaling is not enabled, so clear its conditions and return
+		meta.RemoveStatusCondition(&doclingServe.Status.Conditions, "ScaledObjectCreated")
+		meta.RemoveStatusCondition(&doclingServe.Status.Conditions, "ScaledObjectReady")
+		meta.RemoveStatusCondition(&doclingServe.Status.Conditions, "ScaledObjectActive")
+		return
+	}
+
+	if !r.Capabilities.KEDA {
+		// KEDA is not installed in this cluster, so write a condition as such and return
+		condition := metav1.Condition{
+			Type:               "ScaledObjectCreated",
+			Status:             metav1.ConditionFalse,
+			ObservedGeneration: doclingServe.Generation,
+			Reason:             "KEDAUnavailable",
+			Message:            "KEDA autoscaling was requested but the keda.sh API is not available in the cluster",
+		}
+		meta.SetStatusCondition(&doclingServe.Status.Conditions, condition)
+		meta.RemoveStatusCondition(&doclingServe.Status.Conditions, "ScaledObjectReady")
+		meta.RemoveStatusCondition(&doclingServe.Status.Conditions, "ScaledObjectActive")
+		return
+	}
+
+	scaledObject := NewScaledObject()
+	if err := r.Get(ctx, types.NamespacedName{Name: fmt.Sprintf("%s-scaledobject", doclingServe.Name), Namespace: doclingServe.Namespace}, scaledObject); err != nil {
+		log.Error(err, "failed to get doclingServe scaledobject")
+		condition := metav1.Condition{
+			Type:               "ScaledObjectCreated",
+			Status:             metav1.ConditionUnknown,
+			ObservedGeneration: doclingServe.Generation,
+			Reason:             "ScaledObjectStatusError",
+			Message:            err.Error(),
+		}
+		meta.SetStatusCondition(&doclingServe.Status.Conditions, condition)
+		return
+	}
+
+	// Set created status
+	condition := metav1.Condition{
+		Type:               "ScaledObjectCreated",
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: doclingServe.Generation,
+		Reason:             "ScaledObjectCreated",
+		Message:            "The docling KEDA scaled object was created successfully",
+	}
+	meta.SetStatusCondition(&doclingServe.Status.Conditions, condition)
+
+	// Set the readiness and activity reported by KEDA
+	scaledObjectConditions, _, _ := unstructured.NestedSlice(scaledObject.Object, "status", "conditions")
+	for _, rawCondition := range scaledObjectConditions {
+		scaledObjectCondition, ok := rawCondition.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		conditionType, _, _ := unstructured.NestedString(scaledObjectCondition, "type")
+		if conditionType != "Ready" && conditionType != "Active" {
+			continue
+		}
+		status, _, _ := unstructured.NestedString(scaledObjectCondition, "status")
+		reason, _, _ := unstructured.NestedString(scaledObjectCondition, "reason")
+		message, _, _ := unstructured.NestedString(scaledObjectCondition, "message")
+		// Avoid temporary empty string that results in an error when updating status
+		if reason == "" {
+			reason = "Unknown"
+		}
+		if status == "" {
+			status = string(metav1.ConditionUnknown)
+		}
+		condition := metav1.Condition{
+			Type:               "ScaledObject" + conditionType,
+			Status:             metav1.ConditionStatus(status),
+			ObservedGeneration: doclingServe.Generation,
+			Reason:             reason,
+			Message:            message,
+		}
+		meta.SetStatusCondition(&doclingServe.Status.Conditions, condition)
+	}
+}
+
+func (r *StatusReconciler) reconcileDoclingCertificateStatus(ctx context.Context, doclingServe *v1alpha1.DoclingServe) {
+	log := logf.FromContext(ctx)
+	if tlsProvider(doclingServe) != tlsProviderCertManager {
+		// No cert-manager certificate is requested, so clear its conditions and return
+		meta.RemoveStatusCondition(&doclingServe.Status.Conditions, "CertificateCreated")
+		meta.RemoveStatusCondition(&doclingServe.Status.Conditions, "CertificateReady")
+		return
+	}
+
+	if !r.Capabilities.CertManager {
+		// cert-manager is not installed in this cluster, so write a condition as such and return
+		condition := metav1.Condition{
+			Type:               "CertificateCreated",
+			Status:             metav1.ConditionFalse,
+			ObservedGeneration: doclingServe.Generation,
+			Reason:             "CertManagerUnavailable",
+			Message:            "A cert-manager certificate was requested but the cert-manager.io API is not available in the cluster",
+		}
+		meta.SetStatusCondition(&doclingServe.Status.Conditions, condition)
+		meta.RemoveStatusCondition(&doclingServe.Status.Conditions, "CertificateReady")
+		return
+	}
+
+	certificate := NewCertificate()
+	if err := r.Get(ctx, types.NamespacedName{Name: fmt.Sprintf("%s-certificate", doclingServe.Name), Namespace: doclingServe.Namespace}, certificate); err != nil {
+		log.Error(err, "failed to get doclingServe certificate")
 		condition := metav1.Condition{
-			Type:               string(deployCondition.Type),
-			Status:             metav1.ConditionStatus(deployCondition.Status),
-			ObservedGeneration: deployment.Generation,
-			LastTransitionTime: metav1.Time{},
-			Reason:             deployCondition.Reason,
-			Message:            deployCondition.Message,
+			Type:               "CertificateCreated",
+			Status:             metav1.ConditionUnknown,
+			ObservedGeneration: doclingServe.Generation,
+			Reason:             "CertificateStatusError",
+			Message:            err.Error(),
 		}
 		meta.SetStatusCondition(&doclingServe.Status.Conditions, condition)
+		return
 	}
+
+	// Set created status
+	condition := metav1.Condition{
+		Type:               "CertificateCreated",
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: doclingServe.Generation,
+		Reason:             "CertificateCreated",
+		Message:            "The docling serving certificate was created successfully",
+	}
+	meta.SetStatusCondition(&doclingServe.Status.Conditions, condition)
+
+	// Set the readiness reported by cert-manager, the pods cannot start before the certificate is issued
+	ready := metav1.Condition{
+		Type:               "CertificateReady",
+		Status:             metav1.ConditionUnknown,
+		ObservedGeneration: doclingServe.Generation,
+		Reason:             "IssuancePending",
+		Message:            "cert-manager has not reported the certificate status yet",
+	}
+	certificateConditions, _, _ := unstructured.NestedSlice(certificate.Object, "status", "conditions")
+	for _, rawCondition := range certificateConditions {
+		certificateCondition, ok := rawCondition.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if conditionType, _, _ := unstructured.NestedString(certificateCondition, "type"); conditionType != "Ready" {
+			continue
+		}
+		status, _, _ := unstructured.NestedString(certificateCondition, "status")
+		reason, _, _ := unstructured.NestedString(certificateCondition, "reason")
+		message, _, _ := unstructured.NestedString(certificateCondition, "message")
+		if status != "" {
+			ready.Status = metav1.ConditionStatus(status)
+		}
+		if reason != "" {
+			ready.Reason = reason
+		}
+		ready.Message = message
+	}
+	meta.SetStatusCondition(&doclingServe.Status.Conditions, ready)
 }
 
 func (r *StatusReconciler) reconcileDoclingServiceStatus(ctx context.Context, doclingServe *v1alpha1.DoclingServe) {
@@ -140,8 +648,7 @@ func (r *StatusReconciler) reconcileDoclingServiceStatus(ctx context.Context, do
 		condition := metav1.Condition{
 			Type:               "ServiceCreated",
 			Status:             metav1.ConditionUnknown,
-			ObservedGeneration: service.Generation,
-			LastTransitionTime: metav1.Time{},
+			ObservedGeneration: doclingServe.Generation,
 			Reason:             "ServiceStatusError",
 			Message:            err.Error(),
 		}
@@ -154,8 +661,7 @@ func (r *StatusReconciler) reconcileDoclingServiceStatus(ctx context.Context, do
 		condition := metav1.Condition{
 			Type:               "ServiceCreated",
 			Status:             metav1.ConditionTrue,
-			ObservedGeneration: service.Generation,
-			LastTransitionTime: metav1.Time{},
+			ObservedGeneration: doclingServe.Generation,
 			Reason:             "ServiceCreated",
 			Message:            "The docling service was created successfully",
 		}
@@ -167,37 +673,42 @@ func (r *StatusReconciler) reconcileDoclingServiceStatus(ctx context.Context, do
 		condition := metav1.Condition{
 			Type:               "LoadBalancerAssigned",
 			Status:             metav1.ConditionTrue,
-			ObservedGeneration: service.Generation,
-			LastTransitionTime: metav1.Time{},
+			ObservedGeneration: doclingServe.Generation,
 			Reason:             "LoadBalancerAssigned",
 			Message:            "A LoadBalancer has been assigned to the docling service",
 		}
 		meta.SetStatusCondition(&doclingServe.Status.Conditions, condition)
 	}
+}
 
-	// Set misc statuses for troubleshooting
-	if len(service.Status.Conditions) > 0 {
-		serviceCondition := service.Status.Conditions[len(service.Status.Conditions)-1]
+func (r *StatusReconciler) reconcileDoclingRouteStatus(ctx context.Context, doclingServe *v1alpha1.DoclingServe) {
+	log := logf.FromContext(ctx)
+	if !r.Capabilities.Route {
+		// Routes are not served by this cluster, so report it when a route was requested and return
+		meta.RemoveStatusCondition(&doclingServe.Status.Conditions, "RouteCreated")
+		if doclingServe.Spec.Route == nil || !doclingServe.Spec.Route.Enabled {
+			meta.RemoveStatusCondition(&doclingServe.Status.Conditions, "RouteUnsupported")
+			return
+		}
 		condition := metav1.Condition{
-			Type:               serviceCondition.Type,
-			Status:             serviceCondition.Status,
-			ObservedGeneration: service.Generation,
-			LastTransitionTime: metav1.Time{},
-			Reason:             serviceCondition.Reason,
-			Message:            serviceCondition.Message,
+			Type:               "RouteUnsupported",
+			Status:             metav1.ConditionTrue,
+			ObservedGeneration: doclingServe.Generation,
+			Reason:             "RouteAPIUnavailable",
+			Message:            "A docling route was requested but the route.openshift.io API is not available in the cluster",
 		}
 		meta.SetStatusCondition(&doclingServe.Status.Conditions, condition)
+		return
 	}
-}
+	meta.RemoveStatusCondition(&doclingServe.Status.Conditions, "RouteUnsupported")
 
-func (r *StatusReconciler) reconcileDoclingRouteStatus(ctx context.Context, doclingServe *v1alpha1.DoclingServe) {
-	log := logf.FromContext(ctx)
-	if doclingServe.Spec.Route != nil && !doclingServe.Spec.Route.Enabled {
+	if doclingServe.Spec.Route == nil || !doclingServe.Spec.Route.Enabled {
 		// Route is not enabled, so write a condition as such and return
+		meta.RemoveStatusCondition(&doclingServe.Status.Conditions, "RouteAdmitted")
 		condition := metav1.Condition{
 			Type:               "RouteCreated",
 			Status:             metav1.ConditionTrue,
-			LastTransitionTime: metav1.Time{},
+			ObservedGeneration: doclingServe.Generation,
 			Reason:             "RouteDisabled",
 			Message:            "A docling route is disabled",
 		}
@@ -211,8 +722,7 @@ func (r *StatusReconciler) reconcileDoclingRouteStatus(ctx context.Context, docl
 		condition := metav1.Condition{
 			Type:               "RouteCreated",
 			Status:             metav1.ConditionUnknown,
-			ObservedGeneration: route.Generation,
-			LastTransitionTime: metav1.Time{},
+			ObservedGeneration: doclingServe.Generation,
 			Reason:             "RouteStatusError",
 			Message:            err.Error(),
 		}
@@ -225,29 +735,397 @@ func (r *StatusReconciler) reconcileDoclingRouteStatus(ctx context.Context, docl
 		condition := metav1.Condition{
 			Type:               "RouteCreated",
 			Status:             metav1.ConditionTrue,
-			ObservedGeneration: route.Generation,
-			LastTransitionTime: metav1.Time{},
+			ObservedGeneration: doclingServe.Generation,
 			Reason:             "RouteCreated",
 			Message:            "A docling route was created successfully",
 		}
 		meta.SetStatusCondition(&doclingServe.Status.Conditions, condition)
 	}
 
-	// Set ingress status
-	if len(route.Status.Ingress) > 0 && len(route.Status.Ingress[0].Conditions) > 0 {
-		routeCondition := route.Status.Ingress[0].Conditions[len(route.Status.Ingress[0].Conditions)-1]
+	// Admitted used to be copied from the route under its own type, it is now reported as RouteAdmitted
+	meta.RemoveStatusCondition(&doclingServe.Status.Conditions, string(routev1.RouteAdmitted))
+
+	// Set admission status reported by the first router
+	if len(route.Status.Ingress) == 0 {
+		condition := metav1.Condition{
+			Type:               "RouteAdmitted",
+			Status:             metav1.ConditionUnknown,
+			ObservedGeneration: doclingServe.Generation,
+			Reason:             "AdmissionPending",
+			Message:            "Waiting for a router to admit the docling route",
+		}
+		meta.SetStatusCondition(&doclingServe.Status.Conditions, condition)
+		return
+	}
+	routeIngress := route.Status.Ingress[0]
+	for _, routeCondition := range routeIngress.Conditions {
+		if routeCondition.Type != routev1.RouteAdmitted {
+			continue
+		}
 		// Avoid temporary empty string that results in an error when updating status
-		if routeCondition.Reason == "" {
-			routeCondition.Reason = "Unknown"
+		reason := routeCondition.Reason
+		if reason == "" {
+			reason = "Admitted"
 		}
 		condition := metav1.Condition{
-			Type:               string(routeCondition.Type),
+			Type:               "RouteAdmitted",
 			Status:             metav1.ConditionStatus(routeCondition.Status),
-			ObservedGeneration: route.Generation,
-			LastTransitionTime: metav1.Time{},
-			Reason:             routeCondition.Reason,
+			ObservedGeneration: doclingServe.Generation,
+			Reason:             reason,
 			Message:            routeCondition.Message,
 		}
+		if routeCondition.LastTransitionTime != nil {
+			condition.LastTransitionTime = *routeCondition.LastTransitionTime
+		}
+		meta.SetStatusCondition(&doclingServe.Status.Conditions, condition)
+
+		if routeCondition.Status == corev1.ConditionTrue && doclingServe.Status.URL == "" {
+			doclingServe.Status.URL = "https://" + routeIngress.Host + route.Spec.Path
+		}
+	}
+}
+
+func (r *StatusReconciler) reconcileDoclingNetworkPolicyStatus(ctx context.Context, doclingServe *v1alpha1.DoclingServe) {
+	log := logf.FromContext(ctx)
+	if networkPolicyMode(doclingServe) == networkPolicyModeNone {
+		// No network policy is requested, so clear its condition and return
+		meta.RemoveStatusCondition(&doclingServe.Status.Conditions, "NetworkPolicyCreated")
+		return
+	}
+
+	networkPolicy := networkingv1.NetworkPolicy{}
+	if err := r.Get(ctx, types.NamespacedName{Name: fmt.Sprintf("%s-networkpolicy", doclingServe.Name), Namespace: doclingServe.Namespace}, &networkPolicy); err != nil {
+		log.Error(err, "failed to get doclingServe network policy")
+		condition := metav1.Condition{
+			Type:               "NetworkPolicyCreated",
+			Status:             metav1.ConditionUnknown,
+			ObservedGeneration: doclingServe.Generation,
+			Reason:             "NetworkPolicyStatusError",
+			Message:            err.Error(),
+		}
 		meta.SetStatusCondition(&doclingServe.Status.Conditions, condition)
+		return
+	}
+
+	condition := metav1.Condition{
+		Type:               "NetworkPolicyCreated",
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: doclingServe.Generation,
+		Reason:             "NetworkPolicyCreated",
+		Message:            "A docling network policy was created successfully",
+	}
+	meta.SetStatusCondition(&doclingServe.Status.Conditions, condition)
+}
+
+func (r *StatusReconciler) reconcileDoclingIngressStatus(ctx context.Context, doclingServe *v1alpha1.DoclingServe) {
+	log := logf.FromContext(ctx)
+	if doclingServe.Spec.Ingress == nil || !doclingServe.Spec.Ingress.Enabled {
+		// Ingress is not enabled, so write a condition as such and return
+		condition := metav1.Condition{
+			Type:               "IngressCreated",
+			Status:             metav1.ConditionTrue,
+			ObservedGeneration: doclingServe.Generation,
+			Reason:             "IngressDisabled",
+			Message:            "A docling ingress is disabled",
+		}
+		meta.SetStatusCondition(&doclingServe.Status.Conditions, condition)
+		meta.RemoveStatusCondition(&doclingServe.Status.Conditions, "IngressAddressAssigned")
+		return
+	}
+
+	ingress := networkingv1.Ingress{}
+	if err := r.Get(ctx, types.NamespacedName{Name: fmt.Sprintf("%s-ingress", doclingServe.Name), Namespace: doclingServe.Namespace}, &ingress); err != nil {
+		log.Error(err, "failed to get doclingServe ingress")
+		condition := metav1.Condition{
+			Type:               "IngressCreated",
+			Status:             metav1.ConditionUnknown,
+			ObservedGeneration: doclingServe.Generation,
+			Reason:             "IngressStatusError",
+			Message:            err.Error(),
+		}
+		meta.SetStatusCondition(&doclingServe.Status.Conditions, condition)
+		return
+	}
+
+	// Set created status
+	condition := metav1.Condition{
+		Type:               "IngressCreated",
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: doclingServe.Generation,
+		Reason:             "IngressCreated",
+		Message:            "A docling ingress was created successfully",
+	}
+	meta.SetStatusCondition(&doclingServe.Status.Conditions, condition)
+
+	// Set address status
+	host := "*"
+	if len(ingress.Spec.Rules) > 0 && ingress.Spec.Rules[0].Host != "" {
+		host = ingress.Spec.Rules[0].Host
+	}
+	if len(ingress.Status.LoadBalancer.Ingress) == 0 {
+		condition := metav1.Condition{
+			Type:               "IngressAddressAssigned",
+			Status:             metav1.ConditionFalse,
+			ObservedGeneration: doclingServe.Generation,
+			Reason:             "AddressPending",
+			Message:            fmt.Sprintf("Waiting for the ingress controller to assign an address for host %s", host),
+		}
+		meta.SetStatusCondition(&doclingServe.Status.Conditions, condition)
+		return
+	}
+
+	address := ingress.Status.LoadBalancer.Ingress[0].Hostname
+	if address == "" {
+		address = ingress.Status.LoadBalancer.Ingress[0].IP
+	}
+	condition = metav1.Condition{
+		Type:               "IngressAddressAssigned",
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: doclingServe.Generation,
+		Reason:             "AddressAssigned",
+		Message:            fmt.Sprintf("The docling ingress serves host %s at address %s", host, address),
+	}
+	meta.SetStatusCondition(&doclingServe.Status.Conditions, condition)
+
+	// Set the external URL, unless the route already provides one
+	if doclingServe.Status.URL == "" {
+		scheme := "http"
+		if len(ingress.Spec.TLS) > 0 {
+			scheme = "https"
+		}
+		if host == "*" {
+			host = address
+		}
+		path := doclingServe.Spec.Ingress.Path
+		if path == "" {
+			path = "/"
+		}
+		doclingServe.Status.URL = scheme + "://" + host + path
+	}
+}
+
+func (r *StatusReconciler) reconcileDoclingHTTPRouteStatus(ctx context.Context, doclingServe *v1alpha1.DoclingServe) {
+	log := logf.FromContext(ctx)
+	if doclingServe.Spec.Gateway == nil || !doclingServe.Spec.Gateway.Enabled {
+		// HTTPRoute is not enabled, so clear its conditions and return
+		meta.RemoveStatusCondition(&doclingServe.Status.Conditions, "HTTPRouteCreated")
+		meta.RemoveStatusCondition(&doclingServe.Status.Conditions, "HTTPRouteAccepted")
+		meta.RemoveStatusCondition(&doclingServe.Status.Conditions, "HTTPRouteResolvedRefs")
+		return
+	}
+
+	if !r.Capabilities.GatewayAPI {
+		// The Gateway API is not served by this cluster, so write a condition as such and return
+		condition := metav1.Condition{
+			Type:               "HTTPRouteCreated",
+			Status:             metav1.ConditionFalse,
+			ObservedGeneration: doclingServe.Generation,
+			Reason:             "GatewayAPIUnavailable",
+			Message:            "The Gateway API CRDs are not installed in the cluster",
+		}
+		meta.SetStatusCondition(&doclingServe.Status.Conditions, condition)
+		meta.RemoveStatusCondition(&doclingServe.Status.Conditions, "HTTPRouteAccepted")
+		meta.RemoveStatusCondition(&doclingServe.Status.Conditions, "HTTPRouteResolvedRefs")
+		return
+	}
+
+	httpRoute := gatewayv1.HTTPRoute{}
+	if err := r.Get(ctx, types.NamespacedName{Name: fmt.Sprintf("%s-httproute", doclingServe.Name), Namespace: doclingServe.Namespace}, &httpRoute); err != nil {
+		log.Error(err, "failed to get doclingServe httproute")
+		condition := metav1.Condition{
+			Type:               "HTTPRouteCreated",
+			Status:             metav1.ConditionUnknown,
+			ObservedGeneration: doclingServe.Generation,
+			Reason:             "HTTPRouteStatusError",
+			Message:            err.Error(),
+		}
+		meta.SetStatusCondition(&doclingServe.Status.Conditions, condition)
+		return
+	}
+
+	// Set created status
+	condition := metav1.Condition{
+		Type:               "HTTPRouteCreated",
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: doclingServe.Generation,
+		Reason:             "HTTPRouteCreated",
+		Message:            "A docling HTTPRoute was created successfully",
+	}
+	meta.SetStatusCondition(&doclingServe.Status.Conditions, condition)
+
+	// Set parent statuses reported by the Gateway controller
+	expected := parentRefForGateway(doclingServe.Spec.Gateway)
+	for _, parent := range httpRoute.Status.Parents {
+		if parent.ParentRef.Name != expected.Name || !ptrEqual(parent.ParentRef.Namespace, expected.Namespace) || !ptrEqual(parent.ParentRef.SectionName, expected.SectionName) {
+			continue
+		}
+		for _, conditionType := range []gatewayv1.RouteConditionType{gatewayv1.RouteConditionAccepted, gatewayv1.RouteConditionResolvedRefs} {
+			parentCondition := meta.FindStatusCondition(parent.Conditions, string(conditionType))
+			if parentCondition == nil {
+				continue
+			}
+			reason := parentCondition.Reason
+			// Avoid temporary empty string that results in an error when updating status
+			if reason == "" {
+				reason = "Unknown"
+			}
+			condition := metav1.Condition{
+				Type:               "HTTPRoute" + string(conditionType),
+				Status:             parentCondition.Status,
+				ObservedGeneration: doclingServe.Generation,
+				LastTransitionTime: parentCondition.LastTransitionTime,
+				Reason:             reason,
+				Message:            parentCondition.Message,
+			}
+			meta.SetStatusCondition(&doclingServe.Status.Conditions, condition)
+		}
+	}
+}
+
+// degradedConditions lists the conditions reporting a problem when they have the given status.
+var degradedConditions = []struct {
+	conditionType string
+	status        metav1.ConditionStatus
+}{
+	{"RedisReady", metav1.ConditionUnknown},
+	{"DeploymentCreated", metav1.ConditionUnknown},
+	{"WorkerDeploymentCreated", metav1.ConditionUnknown},
+	{"ConfigReferencesResolved", metav1.ConditionFalse},
+	{"ConfigReferencesResolved", metav1.ConditionUnknown},
+	{"HorizontalPodAutoscalerCreated", metav1.ConditionUnknown},
+	{"ScaledObjectCreated", metav1.ConditionFalse},
+	{"ScaledObjectCreated", metav1.ConditionUnknown},
+	{"CertificateCreated", metav1.ConditionFalse},
+	{"CertificateCreated", metav1.ConditionUnknown},
+	{"ServiceCreated", metav1.ConditionUnknown},
+	{"NetworkPolicyCreated", metav1.ConditionUnknown},
+	{"RouteUnsupported", metav1.ConditionTrue},
+	{"RouteCreated", metav1.ConditionUnknown},
+	{"RouteAdmitted", metav1.ConditionFalse},
+	{"IngressCreated", metav1.ConditionUnknown},
+	{"HTTPRouteCreated", metav1.ConditionFalse},
+	{"HTTPRouteCreated", metav1.ConditionUnknown},
+	{"HTTPRouteAccepted", metav1.ConditionFalse},
+	{"HTTPRouteResolvedRefs", metav1.ConditionFalse},
+}
+
+// pendingConditions lists the conditions that must be True before the DoclingServe is Ready, when they are reported.
+var pendingConditions = []string{"ModelsReady", "RedisReady", "WorkerDeploymentAvailable", "CertificateReady", "RouteAdmitted", "HTTPRouteAccepted"}
+
+func (r *StatusReconciler) reconcileDoclingAggregatedStatus(doclingServe *v1alpha1.DoclingServe, deployment, worker *appsv1.Deployment) {
+	// Set degraded status
+	degraded := metav1.Condition{
+		Type:               "Degraded",
+		Status:             metav1.ConditionFalse,
+		ObservedGeneration: doclingServe.Generation,
+		Reason:             "AsExpected",
+		Message:            "All the docling resources are healthy",
+	}
+	for _, degradedCondition := range degradedConditions {
+		condition := meta.FindStatusCondition(doclingServe.Status.Conditions, degradedCondition.conditionType)
+		if condition != nil && condition.Status == degradedCondition.status {
+			degraded.Status = metav1.ConditionTrue
+			degraded.Reason = condition.Reason
+			degraded.Message = fmt.Sprintf("%s: %s", condition.Type, condition.Message)
+			break
+		}
+	}
+	if condition := meta.FindStatusCondition(doclingServe.Status.Conditions, "ModelsReady"); degraded.Status == metav1.ConditionFalse &&
+		condition != nil && (condition.Reason == "ModelsDownloadFailed" || condition.Reason == "ModelCacheNotFound") {
+		degraded.Status = metav1.ConditionTrue
+		degraded.Reason = condition.Reason
+		degraded.Message = fmt.Sprintf("%s: %s", condition.Type, condition.Message)
+	}
+	for _, target := range []*appsv1.Deployment{deployment, worker} {
+		if degraded.Status == metav1.ConditionTrue || target == nil {
+			continue
+		}
+		for _, deployCondition := range target.Status.Conditions {
+			if (deployCondition.Type == appsv1.DeploymentReplicaFailure && deployCondition.Status == corev1.ConditionTrue) ||
+				(deployCondition.Type == appsv1.DeploymentProgressing && deployCondition.Reason == "ProgressDeadlineExceeded") {
+				degraded.Status = metav1.ConditionTrue
+				degraded.Reason = deployCondition.Reason
+				degraded.Message = deployCondition.Message
+				break
+			}
+		}
+	}
+	meta.SetStatusCondition(&doclingServe.Status.Conditions, degraded)
+
+	// Set progressing status
+	progressing := metav1.Condition{
+		Type:               "Progressing",
+		Status:             metav1.ConditionFalse,
+		ObservedGeneration: doclingServe.Generation,
+		Reason:             "RolloutComplete",
+		Message:            "The docling deployment is up to date",
+	}
+	models := meta.FindStatusCondition(doclingServe.Status.Conditions, "ModelsReady")
+	switch {
+	case models != nil && models.Reason == "ModelsDownloading":
+		progressing.Status = metav1.ConditionTrue
+		progressing.Reason = "DownloadingModels"
+		progressing.Message = "The docling models are downloaded before the deployment rolls out"
+	case deployment == nil:
+		progressing.Status = metav1.ConditionUnknown
+		progressing.Reason = "DeploymentUnavailable"
+		progressing.Message = "The docling deployment could not be read"
+	case !deploymentRolledOut(deployment):
+		progressing.Status = metav1.ConditionTrue
+		progressing.Reason = "RollingOut"
+		progressing.Message = fmt.Sprintf("%d of %d docling-serve pods are updated and available",
+			min(deployment.Status.UpdatedReplicas, deployment.Status.AvailableReplicas), ptr.Deref(deployment.Spec.Replicas, 1))
+	case worker != nil && !deploymentRolledOut(worker):
+		progressing.Status = metav1.ConditionTrue
+		progressing.Reason = "WorkersRollingOut"
+		progressing.Message = fmt.Sprintf("%d of %d docling worker pods are updated and available",
+			min(worker.Status.UpdatedReplicas, worker.Status.AvailableReplicas), ptr.Deref(worker.Spec.Replicas, 1))
+	}
+	meta.SetStatusCondition(&doclingServe.Status.Conditions, progressing)
+
+	// Set ready status
+	ready := metav1.Condition{
+		Type:               "Ready",
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: doclingServe.Generation,
+		Reason:             "Ready",
+		Message:            "docling-serve is ready to serve requests",
+	}
+	available := meta.FindStatusCondition(doclingServe.Status.Conditions, "DeploymentAvailable")
+	switch {
+	case degraded.Status == metav1.ConditionTrue:
+		ready.Status = metav1.ConditionFalse
+		ready.Reason = "Degraded"
+		ready.Message = degraded.Message
+	case available == nil || available.Status != metav1.ConditionTrue:
+		ready.Status = metav1.ConditionFalse
+		ready.Reason = "DeploymentUnavailable"
+		ready.Message = "The docling deployment does not have the minimum number of available pods"
+	default:
+		for _, conditionType := range pendingConditions {
+			if condition := meta.FindStatusCondition(doclingServe.Status.Conditions, conditionType); condition != nil && condition.Status != metav1.ConditionTrue {
+				ready.Status = metav1.ConditionFalse
+				ready.Reason = conditionType + "Pending"
+				ready.Message = condition.Message
+				break
+			}
+		}
+	}
+	meta.SetStatusCondition(&doclingServe.Status.Conditions, ready)
+}
+
+// deploymentRolledOut reports whether the latest pod template of the deployment is fully rolled out, like kubectl rollout status.
+func deploymentRolledOut(deployment *appsv1.Deployment) bool {
+	desired := ptr.Deref(deployment.Spec.Replicas, 1)
+	return deployment.Status.ObservedGeneration >= deployment.Generation &&
+		deployment.Status.UpdatedReplicas >= desired &&
+		deployment.Status.Replicas == deployment.Status.UpdatedReplicas &&
+		deployment.Status.AvailableReplicas == deployment.Status.UpdatedReplicas
+}
+
+func ptrEqual[T comparable](a, b *T) bool {
+	if a == nil || b == nil {
+		return a == b
 	}
+	return *a == *b
 }
@@ -2,6 +2,7 @@ package reconcilers
 
 import (
 	"context"
+	"time"
 
 	"github.io/docling-project/docling-operator/api/v1alpha1"
 )
@@ -9,3 +10,9 @@ import (
 type Reconciler interface {
 	Reconcile(ctx context.Context, doclingServe *v1alpha1.DoclingServe) (bool, error)
 }
+
+// ScheduledReconciler is implemented by the reconcilers that need to run again at a later point in time,
+// e.g. to rotate a credential. RequeueAfter is read after Reconcile and ignored when it is not positive.
+type ScheduledReconciler interface {
+	RequeueAfter() time.Duration
+}
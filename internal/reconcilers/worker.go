@@ -0,0 +1,190 @@
+package reconcilers
+
+import (
+	"context"
+
+	"github.io/docling-project/docling-operator/api/v1alpha1"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// WorkerDeploymentReconciler runs the RQ workers processing the tasks queued by the docling-serve pods, in their own
+// Deployment sized independently of the API server.
+type WorkerDeploymentReconciler struct {
+	client.Client
+	Scheme       *runtime.Scheme
+	Capabilities Capabilities
+}
+
+func NewWorkerDeploymentReconciler(client client.Client, scheme *runtime.Scheme, capabilities Capabilities) *WorkerDeploymentReconciler {
+	return &WorkerDeploymentReconciler{
+		Client:       client,
+		Scheme:       scheme,
+		Capabilities: capabilities,
+	}
+}
+
+func (r *WorkerDeploymentReconciler) Reconcile(ctx context.Context, doclingServe *v1alpha1.DoclingServe) (bool, error) {
+	if rqEngine(doclingServe) != nil {
+		return r.createOrUpdate(ctx, doclingServe)
+	}
+
+	return r.delete(ctx, doclingServe)
+}
+
+func (r *WorkerDeploymentReconciler) createOrUpdate(ctx context.Context, doclingServe *v1alpha1.DoclingServe) (bool, error) {
+	log := logf.FromContext(ctx)
+
+	// The workers convert the documents, they wait for the models like the docling-serve pods.
+	pending, err := modelsPending(ctx, r.Client, doclingServe, workerDeploymentName(doclingServe))
+	if err != nil {
+		log.Error(err, "Error getting the state of the models", "DoclingServe.Namespace", doclingServe.Namespace, "DoclingServe.Name", doclingServe.Name)
+		return true, err
+	}
+	if pending {
+		log.Info("Waiting for the models download before creating worker Deployment", "Deployment.Namespace", doclingServe.Namespace, "Deployment.Name", workerDeploymentName(doclingServe))
+		return false, nil
+	}
+
+	deployment := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: workerDeploymentName(doclingServe), Namespace: doclingServe.Namespace}}
+	_, err = controllerutil.CreateOrUpdate(ctx, r.Client, deployment, func() error {
+		labels := labelsForWorker(doclingServe.Name)
+		deployment.Labels = labels
+		if deployment.CreationTimestamp.IsZero() {
+			deployment.Spec.Selector = &metav1.LabelSelector{
+				MatchLabels: labels,
+			}
+		}
+		deployment.Spec.Replicas = &rqEngine(doclingServe).Workers
+
+		podSecurity, err := podSecurityContext(doclingServe)
+		if err != nil {
+			return err
+		}
+		containerSecurity, err := containerSecurityContext(doclingServe)
+		if err != nil {
+			return err
+		}
+
+		deployment.Spec.Template = corev1.PodTemplateSpec{
+			ObjectMeta: metav1.ObjectMeta{
+				Labels: labels,
+			},
+			Spec: corev1.PodSpec{
+				ServiceAccountName: serviceAccountName(doclingServe),
+				SecurityContext:    podSecurity,
+				Containers: []corev1.Container{
+					{
+						Image: doclingServe.Spec.APIServer.Image,
+						Name:  "docling-serve-worker",
+						Command: []string{
+							"docling-serve",
+							"rq-worker",
+						},
+						ImagePullPolicy: corev1.PullIfNotPresent,
+						SecurityContext: containerSecurity,
+					},
+				},
+			},
+		}
+		container := &deployment.Spec.Template.Spec.Containers[0]
+
+		applyWorkerScheduling(doclingServe, &deployment.Spec.Template.Spec)
+
+		settingsEnv, err := settingsEnv(doclingServe)
+		if err != nil {
+			return err
+		}
+		container.Env = append(container.Env, settingsEnv...)
+		container.Env = append(container.Env, engineEnv(doclingServe)...)
+
+		if len(doclingServe.Spec.APIServer.ConfigMapName) > 0 {
+			container.EnvFrom = append(container.EnvFrom, corev1.EnvFromSource{
+				ConfigMapRef: &corev1.ConfigMapEnvSource{
+					LocalObjectReference: corev1.LocalObjectReference{Name: doclingServe.Spec.APIServer.ConfigMapName},
+					Optional:             new(bool),
+				},
+			})
+		}
+
+		// The workers read the same configuration as the docling-serve pods, and roll out with them when it changes.
+		hash, _, err := configHash(ctx, r.Client, doclingServe)
+		if err != nil {
+			return err
+		}
+		if hash != "" {
+			deployment.Spec.Template.Annotations = map[string]string{configHashAnnotation: hash}
+		}
+
+		if resources := workerResources(doclingServe); resources != nil {
+			container.Resources = *resources
+		}
+
+		addDoclingVolumes(doclingServe, &deployment.Spec.Template.Spec, containerSecurity, r.Capabilities)
+
+		// The user variables come last, the webhook rejects the names the operator sets.
+		container = &deployment.Spec.Template.Spec.Containers[0]
+		container.Env = append(container.Env, doclingServe.Spec.APIServer.Env...)
+		container.EnvFrom = append(container.EnvFrom, doclingServe.Spec.APIServer.EnvFrom...)
+
+		_ = ctrl.SetControllerReference(doclingServe, deployment, r.Scheme)
+		return nil
+	})
+	if err != nil {
+		log.Error(err, "Error reconciling worker Deployment", "Deployment.Namespace", deployment.Namespace, "Deployment.Name", deployment.Name)
+		return true, err
+	}
+
+	log.Info("Successfully reconciled worker Deployment", "Deployment.Namespace", deployment.Namespace, "Deployment.Name", deployment.Name)
+	return false, nil
+}
+
+func (r *WorkerDeploymentReconciler) delete(ctx context.Context, doclingServe *v1alpha1.DoclingServe) (bool, error) {
+	log := logf.FromContext(ctx)
+	deployment := &appsv1.Deployment{}
+	err := r.Get(ctx, types.NamespacedName{Name: workerDeploymentName(doclingServe), Namespace: doclingServe.Namespace}, deployment)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return false, nil
+		}
+		log.Error(err, "Error getting worker Deployment", "Deployment.Namespace", doclingServe.Namespace, "Deployment.Name", workerDeploymentName(doclingServe))
+		return true, err
+	}
+
+	if !metav1.IsControlledBy(deployment, doclingServe) {
+		return false, nil
+	}
+
+	if err := r.Delete(ctx, deployment); err != nil && !errors.IsNotFound(err) {
+		log.Error(err, "Error deleting worker Deployment", "Deployment.Namespace", deployment.Namespace, "Deployment.Name", deployment.Name)
+		return true, err
+	}
+
+	log.Info("Successfully deleted worker Deployment", "Deployment.Namespace", deployment.Namespace, "Deployment.Name", deployment.Name)
+	return false, nil
+}
+
+// workerResources returns the resources of the worker container, the docling-serve ones unless spec.worker sets them.
+func workerResources(doclingServe *v1alpha1.DoclingServe) *corev1.ResourceRequirements {
+	if doclingServe.Spec.Worker != nil && doclingServe.Spec.Worker.Resources != nil {
+		return doclingServe.Spec.Worker.Resources
+	}
+	return doclingServe.Spec.APIServer.Resources
+}
+
+func workerDeploymentName(doclingServe *v1alpha1.DoclingServe) string {
+	return doclingServe.Name + "-worker"
+}
+
+// labelsForWorker labels the RQ workers, their pods are not selected by the docling-serve services.
+func labelsForWorker(name string) map[string]string {
+	return map[string]string{"app": "docling-serve-worker", "doclingserve_cr": name}
+}